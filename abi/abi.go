@@ -0,0 +1,172 @@
+package abi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/inwecrypto/sha3"
+)
+
+// Errors
+var (
+	ErrMethod    = errors.New("abi: method not found")
+	ErrAmbiguous = errors.New("abi: overloaded method name, use method signature instead")
+)
+
+// Method contract function or constructor
+type Method struct {
+	Name            string
+	Type            string // function, constructor, fallback or receive
+	Inputs          Arguments
+	Outputs         Arguments
+	StateMutability string
+}
+
+// ABI parsed contract json abi
+type ABI struct {
+	Constructor *Method
+	Methods     []*Method
+	Events      []*Event
+}
+
+type entryJSON struct {
+	Type            string    `json:"type"`
+	Name            string    `json:"name"`
+	Inputs          Arguments `json:"inputs"`
+	Outputs         Arguments `json:"outputs"`
+	StateMutability string    `json:"stateMutability"`
+	Anonymous       bool      `json:"anonymous"`
+}
+
+// Parse parse contract json abi
+func Parse(data []byte) (*ABI, error) {
+	var entries []entryJSON
+
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	abi := &ABI{}
+
+	for _, entry := range entries {
+		method := &Method{
+			Name:            entry.Name,
+			Type:            entry.Type,
+			Inputs:          entry.Inputs,
+			Outputs:         entry.Outputs,
+			StateMutability: entry.StateMutability,
+		}
+
+		switch entry.Type {
+		case "function", "":
+			method.Type = "function"
+			abi.Methods = append(abi.Methods, method)
+		case "constructor":
+			abi.Constructor = method
+		case "event":
+			abi.Events = append(abi.Events, &Event{
+				Name:      entry.Name,
+				Inputs:    entry.Inputs,
+				Anonymous: entry.Anonymous,
+			})
+		}
+	}
+
+	return abi, nil
+}
+
+// NewMethod create method from signature like transfer(address,uint256)
+func NewMethod(signature string) (*Method, error) {
+	start := strings.Index(signature, "(")
+
+	if start <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("abi: invalid method signature %s", signature)
+	}
+
+	typ, err := NewType(signature[start:], nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Method{
+		Name:   strings.TrimSpace(signature[:start]),
+		Type:   "function",
+		Inputs: typ.Components,
+	}, nil
+}
+
+// Method find method by name or signature, overloaded methods must be found by signature
+func (abi *ABI) Method(name string) (*Method, error) {
+	var found *Method
+
+	for _, method := range abi.Methods {
+		if method.Signature() == name {
+			return method, nil
+		}
+
+		if method.Name == name {
+			if found != nil {
+				return nil, ErrAmbiguous
+			}
+
+			found = method
+		}
+	}
+
+	if found == nil {
+		return nil, ErrMethod
+	}
+
+	return found, nil
+}
+
+// Pack encode call data of method found by name or signature
+func (abi *ABI) Pack(name string, args ...interface{}) ([]byte, error) {
+	method, err := abi.Method(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Pack(args...)
+}
+
+// PackConstructor encode constructor arguments, which are appended to contract bytecode
+func (abi *ABI) PackConstructor(args ...interface{}) ([]byte, error) {
+	if abi.Constructor == nil {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("abi: constructor takes no arguments")
+		}
+
+		return nil, nil
+	}
+
+	return abi.Constructor.Inputs.Pack(args...)
+}
+
+// Signature method signature like transfer(address,uint256)
+func (method *Method) Signature() string {
+	return method.Name + "(" + method.Inputs.types() + ")"
+}
+
+// ID 4 bytes method selector
+func (method *Method) ID() []byte {
+	hasher := sha3.NewKeccak256()
+	hasher.Write([]byte(method.Signature()))
+
+	return hasher.Sum(nil)[:4]
+}
+
+// Pack encode call data: selector followed by encoded arguments
+func (method *Method) Pack(args ...interface{}) ([]byte, error) {
+	data, err := method.Inputs.Pack(args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(method.ID(), data...), nil
+}
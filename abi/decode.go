@@ -0,0 +1,222 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// ErrData return data doesn't match output types
+var ErrData = errors.New("abi: malformed return data")
+
+// ParseArguments parse comma separated type list like "string,uint8" or "(int256[] x,int256[] y)"
+func ParseArguments(types string) (Arguments, error) {
+	types = strings.TrimSpace(types)
+
+	if !strings.HasPrefix(types, "(") || !strings.HasSuffix(types, ")") {
+		types = "(" + types + ")"
+	}
+
+	typ, err := NewType(types, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return typ.Components, nil
+}
+
+// Unpack decode return data, integers are decimal strings, addresses are checksummed,
+// bytes are 0x prefixed hex, tuples are objects keyed by component names or lists
+// when any component is unnamed
+func (arguments Arguments) Unpack(data []byte) ([]interface{}, error) {
+	types := make([]*Type, 0, len(arguments))
+
+	for _, argument := range arguments {
+		types = append(types, argument.Type)
+	}
+
+	return decodeSequence(types, data)
+}
+
+// UnpackJSON decode return data into json object keyed by argument names, or json
+// array when any argument is unnamed
+func (arguments Arguments) UnpackJSON(data []byte) (string, error) {
+	values, err := arguments.Unpack(data)
+
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.Marshal(arguments.named(values))
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+func (arguments Arguments) named(values []interface{}) interface{} {
+	object := make(map[string]interface{}, len(arguments))
+
+	for i, argument := range arguments {
+		if argument.Name == "" {
+			return values
+		}
+
+		object[argument.Name] = values[i]
+	}
+
+	return object
+}
+
+func decodeSequence(types []*Type, data []byte) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(types))
+
+	position := 0
+
+	for _, typ := range types {
+		var (
+			value interface{}
+			err   error
+		)
+
+		if typ.Dynamic() {
+			offset, err := readLength(data, position)
+
+			if err != nil {
+				return nil, err
+			}
+
+			value, err = decodeValue(typ, data[offset:])
+
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			if position > len(data) {
+				return nil, ErrData
+			}
+
+			if value, err = decodeValue(typ, data[position:]); err != nil {
+				return nil, err
+			}
+		}
+
+		values = append(values, value)
+		position += typ.headSize()
+	}
+
+	return values, nil
+}
+
+func decodeValue(typ *Type, data []byte) (interface{}, error) {
+	switch typ.Kind {
+	case ArrayKind, SliceKind:
+		size := typ.Size
+
+		if typ.Kind == SliceKind {
+			length, err := readLength(data, 0)
+
+			if err != nil {
+				return nil, err
+			}
+
+			size, data = length, data[32:]
+		}
+
+		// every element takes at least one word in the head
+		if size*32 > len(data) {
+			return nil, ErrData
+		}
+
+		types := make([]*Type, size)
+
+		for i := range types {
+			types[i] = typ.Elem
+		}
+
+		return decodeSequence(types, data)
+	case TupleKind:
+		values, err := typ.Components.Unpack(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return typ.Components.named(values), nil
+	case BytesKind, StringKind:
+		length, err := readLength(data, 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if 32+length > len(data) {
+			return nil, ErrData
+		}
+
+		content := data[32 : 32+length]
+
+		if typ.Kind == StringKind {
+			return string(content), nil
+		}
+
+		return "0x" + hex.EncodeToString(content), nil
+	}
+
+	if len(data) < 32 {
+		return nil, ErrData
+	}
+
+	word := data[:32]
+
+	switch typ.Kind {
+	case UintKind, IntKind:
+		value := new(big.Int).SetBytes(word)
+
+		if typ.Kind == IntKind && word[0]&0x80 != 0 {
+			value.Sub(value, new(big.Int).Lsh(big1, 256))
+		}
+
+		if checkRange(typ, value) != nil {
+			return nil, ErrData
+		}
+
+		return value.String(), nil
+	case AddressKind:
+		return ethtx.ChecksumAddress(word[12:]), nil
+	case BoolKind:
+		value := new(big.Int).SetBytes(word)
+
+		if value.Cmp(big1) > 0 {
+			return nil, ErrData
+		}
+
+		return value.Sign() == 1, nil
+	case FixedBytesKind:
+		return "0x" + hex.EncodeToString(word[:typ.Size]), nil
+	}
+
+	return nil, ErrData
+}
+
+// readLength read word at position as offset or length not exceeding data
+func readLength(data []byte, position int) (int, error) {
+	if position+32 > len(data) {
+		return 0, ErrData
+	}
+
+	value := new(big.Int).SetBytes(data[position : position+32])
+
+	if !value.IsInt64() || value.Int64() > int64(len(data)) {
+		return 0, ErrData
+	}
+
+	return int(value.Int64()), nil
+}
@@ -0,0 +1,327 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var (
+	big1    = big.NewInt(1)
+	maxWord = new(big.Int).Sub(new(big.Int).Lsh(big1, 256), big1)
+)
+
+// Pack encode arguments, integers can be json numbers, decimal or 0x hex strings and
+// *big.Int, bytes are 0x hex strings or []byte, tuples are lists or objects keyed by
+// component name
+func (arguments Arguments) Pack(args ...interface{}) ([]byte, error) {
+	if len(args) != len(arguments) {
+		return nil, fmt.Errorf("abi: expect %d arguments, got %d", len(arguments), len(args))
+	}
+
+	types := make([]*Type, 0, len(arguments))
+
+	for _, argument := range arguments {
+		types = append(types, argument.Type)
+	}
+
+	return encodeSequence(types, args)
+}
+
+// PackJSON encode arguments from json array, or object keyed by argument names
+func (arguments Arguments) PackJSON(data string) ([]byte, error) {
+	if strings.TrimSpace(data) == "" {
+		return arguments.Pack()
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(data))
+
+	decoder.UseNumber()
+
+	var value interface{}
+
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	values, err := arguments.values(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return arguments.Pack(values...)
+}
+
+// values positional values of list or object keyed by argument names
+func (arguments Arguments) values(value interface{}) ([]interface{}, error) {
+	if object, ok := value.(map[string]interface{}); ok {
+		values := make([]interface{}, 0, len(arguments))
+
+		for _, argument := range arguments {
+			item, ok := object[argument.Name]
+
+			if !ok {
+				return nil, fmt.Errorf("abi: missing argument %s", argument.Name)
+			}
+
+			values = append(values, item)
+		}
+
+		return values, nil
+	}
+
+	list := reflect.ValueOf(value)
+
+	if value == nil || (list.Kind() != reflect.Slice && list.Kind() != reflect.Array) {
+		return nil, fmt.Errorf("abi: expect list or object, got %v", value)
+	}
+
+	values := make([]interface{}, 0, list.Len())
+
+	for i := 0; i < list.Len(); i++ {
+		values = append(values, list.Index(i).Interface())
+	}
+
+	return values, nil
+}
+
+func encodeSequence(types []*Type, values []interface{}) ([]byte, error) {
+	headSize := 0
+
+	for _, typ := range types {
+		headSize += typ.headSize()
+	}
+
+	var head, tail []byte
+
+	for i, typ := range types {
+		data, err := encode(typ, values[i])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !typ.Dynamic() {
+			head = append(head, data...)
+			continue
+		}
+
+		head = append(head, word(big.NewInt(int64(headSize+len(tail))))...)
+		tail = append(tail, data...)
+	}
+
+	return append(head, tail...), nil
+}
+
+func encode(typ *Type, value interface{}) ([]byte, error) {
+	switch typ.Kind {
+	case UintKind, IntKind:
+		number, err := toBigint(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkRange(typ, number); err != nil {
+			return nil, err
+		}
+
+		return word(number), nil
+	case AddressKind:
+		data, err := toBytes(value)
+
+		if err != nil || len(data) != 20 {
+			return nil, fmt.Errorf("abi: invalid address %v", value)
+		}
+
+		return leftPad(data), nil
+	case BoolKind:
+		flag, ok := value.(bool)
+
+		if !ok {
+			return nil, fmt.Errorf("abi: invalid bool %v", value)
+		}
+
+		if flag {
+			return word(big1), nil
+		}
+
+		return word(new(big.Int)), nil
+	case FixedBytesKind:
+		data, err := toBytes(value)
+
+		if err != nil || len(data) != typ.Size {
+			return nil, fmt.Errorf("abi: invalid %s %v", typ, value)
+		}
+
+		return rightPad(data), nil
+	case BytesKind:
+		data, err := toBytes(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return append(word(big.NewInt(int64(len(data)))), rightPad(data)...), nil
+	case StringKind:
+		text, ok := value.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("abi: invalid string %v", value)
+		}
+
+		return append(word(big.NewInt(int64(len(text)))), rightPad([]byte(text))...), nil
+	case ArrayKind, SliceKind:
+		values, err := Arguments(nil).values(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if typ.Kind == ArrayKind && len(values) != typ.Size {
+			return nil, fmt.Errorf("abi: expect %d elements for %s, got %d", typ.Size, typ, len(values))
+		}
+
+		types := make([]*Type, len(values))
+
+		for i := range types {
+			types[i] = typ.Elem
+		}
+
+		data, err := encodeSequence(types, values)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if typ.Kind == SliceKind {
+			data = append(word(big.NewInt(int64(len(values)))), data...)
+		}
+
+		return data, nil
+	case TupleKind:
+		values, err := typ.Components.values(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return typ.Components.Pack(values...)
+	}
+
+	return nil, fmt.Errorf("abi: unsupported type %s", typ)
+}
+
+func checkRange(typ *Type, number *big.Int) error {
+	if typ.Kind == UintKind {
+		if number.Sign() < 0 || number.BitLen() > typ.Size {
+			return fmt.Errorf("abi: %s out of %s range", number, typ)
+		}
+
+		return nil
+	}
+
+	limit := new(big.Int).Lsh(big1, uint(typ.Size-1))
+
+	if number.Cmp(limit) >= 0 || number.Cmp(new(big.Int).Neg(limit)) < 0 {
+		return fmt.Errorf("abi: %s out of %s range", number, typ)
+	}
+
+	return nil
+}
+
+// word 32 bytes two's complement big endian encoding
+func word(number *big.Int) []byte {
+	return leftPad(new(big.Int).And(number, maxWord).Bytes())
+}
+
+func leftPad(data []byte) []byte {
+	return append(make([]byte, 32-len(data)), data...)
+}
+
+func rightPad(data []byte) []byte {
+	if len(data)%32 == 0 {
+		return data
+	}
+
+	return append(append([]byte{}, data...), make([]byte, 32-len(data)%32)...)
+}
+
+func toBigint(value interface{}) (*big.Int, error) {
+	switch number := value.(type) {
+	case *big.Int:
+		return number, nil
+	case json.Number:
+		return parseBigint(number.String())
+	case string:
+		return parseBigint(number)
+	case int:
+		return big.NewInt(int64(number)), nil
+	case int64:
+		return big.NewInt(number), nil
+	case uint64:
+		return new(big.Int).SetUint64(number), nil
+	case float64:
+		if number != float64(int64(number)) {
+			return nil, fmt.Errorf("abi: invalid integer %v", value)
+		}
+
+		return big.NewInt(int64(number)), nil
+	}
+
+	return nil, fmt.Errorf("abi: invalid integer %v", value)
+}
+
+// parseBigint parse decimal or 0x prefixed hex integer, both can be negative
+func parseBigint(text string) (*big.Int, error) {
+	text = strings.TrimSpace(text)
+
+	negative := strings.HasPrefix(text, "-")
+
+	text = strings.TrimPrefix(text, "-")
+
+	base := 10
+
+	if strings.HasPrefix(text, "0x") || strings.HasPrefix(text, "0X") {
+		text, base = text[2:], 16
+	}
+
+	number, ok := new(big.Int).SetString(text, base)
+
+	if !ok {
+		return nil, fmt.Errorf("abi: invalid integer %s", text)
+	}
+
+	if negative {
+		number.Neg(number)
+	}
+
+	return number, nil
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch data := value.(type) {
+	case []byte:
+		return data, nil
+	case string:
+		if !strings.HasPrefix(data, "0x") && !strings.HasPrefix(data, "0X") {
+			return nil, fmt.Errorf("abi: bytes must be 0x prefixed hex %s", data)
+		}
+
+		return hex.DecodeString(data[2:])
+	}
+
+	if array := reflect.ValueOf(value); array.Kind() == reflect.Array && array.Type().Elem().Kind() == reflect.Uint8 {
+		data := make([]byte, array.Len())
+
+		reflect.Copy(reflect.ValueOf(data), array)
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("abi: invalid bytes %v", value)
+}
@@ -0,0 +1,130 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/inwecrypto/sha3"
+)
+
+// Errors
+var (
+	ErrEvent  = errors.New("abi: event not found")
+	ErrTopics = errors.New("abi: topics don't match event")
+)
+
+// Event contract event
+type Event struct {
+	Name      string
+	Inputs    Arguments
+	Anonymous bool
+}
+
+// Log decoded event log, args are keyed by input names, unnamed inputs by argN
+type Log struct {
+	Event     string                 `json:"event"`
+	Signature string                 `json:"signature"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+// Signature event signature like Transfer(address,address,uint256)
+func (event *Event) Signature() string {
+	return event.Name + "(" + event.Inputs.types() + ")"
+}
+
+// ID topic0 of event, keccak256 of signature
+func (event *Event) ID() []byte {
+	hasher := sha3.NewKeccak256()
+	hasher.Write([]byte(event.Signature()))
+
+	return hasher.Sum(nil)
+}
+
+// Unpack decode indexed inputs from topics and the others from data, indexed reference
+// types (string, bytes, arrays and tuples) are only available as keccak256 hash
+func (event *Event) Unpack(topics [][]byte, data []byte) (*Log, error) {
+	if !event.Anonymous {
+		if len(topics) == 0 || !bytes.Equal(topics[0], event.ID()) {
+			return nil, ErrTopics
+		}
+
+		topics = topics[1:]
+	}
+
+	var unindexed Arguments
+
+	for _, input := range event.Inputs {
+		if !input.Indexed {
+			unindexed = append(unindexed, input)
+		}
+	}
+
+	if len(topics) != len(event.Inputs)-len(unindexed) {
+		return nil, ErrTopics
+	}
+
+	values, err := unindexed.Unpack(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	log := &Log{
+		Event:     event.Name,
+		Signature: event.Signature(),
+		Args:      make(map[string]interface{}, len(event.Inputs)),
+	}
+
+	for i, input := range event.Inputs {
+		name := input.Name
+
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+
+		if !input.Indexed {
+			log.Args[name], values = values[0], values[1:]
+			continue
+		}
+
+		topic := topics[0]
+		topics = topics[1:]
+
+		if len(topic) != 32 {
+			return nil, ErrTopics
+		}
+
+		switch input.Type.Kind {
+		case StringKind, BytesKind, ArrayKind, SliceKind, TupleKind:
+			log.Args[name] = "0x" + hex.EncodeToString(topic)
+		default:
+			if log.Args[name], err = decodeValue(input.Type, topic); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return log, nil
+}
+
+// DecodeLog decode log with the first event matching topic0 and indexed inputs count,
+// anonymous events are skipped
+func (abi *ABI) DecodeLog(topics [][]byte, data []byte) (*Log, error) {
+	for _, event := range abi.Events {
+		if event.Anonymous {
+			continue
+		}
+
+		log, err := event.Unpack(topics, data)
+
+		if err == ErrTopics {
+			continue
+		}
+
+		return log, err
+	}
+
+	return nil, ErrEvent
+}
@@ -0,0 +1,118 @@
+package abitest
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/abi"
+	"github.com/stretchr/testify/assert"
+)
+
+func unhex(data ...string) []byte {
+	bytes, _ := hex.DecodeString(strings.Join(data, ""))
+
+	return bytes
+}
+
+func TestUnpackRoundTrip(t *testing.T) {
+	method, err := abi.NewMethod("g(uint256[][],string[],int8,bytes3,address,bool)")
+
+	assert.NoError(t, err)
+
+	data, err := method.Inputs.PackJSON(`[[[1, 2], [3]], ["one", "two", "three"], -5, "0x616263", "0x3535353535353535353535353535353535353535", true]`)
+
+	assert.NoError(t, err)
+
+	values, err := method.Inputs.Unpack(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		[]interface{}{[]interface{}{"1", "2"}, []interface{}{"3"}},
+		[]interface{}{"one", "two", "three"},
+		"-5",
+		"0x616263",
+		"0x3535353535353535353535353535353535353535",
+		true,
+	}, values)
+}
+
+func TestUnpackJSON(t *testing.T) {
+	contract, err := abi.Parse([]byte(tupleABI))
+
+	assert.NoError(t, err)
+
+	method, err := contract.Method("submit")
+
+	assert.NoError(t, err)
+
+	data, err := method.Inputs.PackJSON(`[["0x3535353535353535353535353535353535353535", [1, 2], [3, "hi"]], true]`)
+
+	assert.NoError(t, err)
+
+	result, err := method.Inputs.UnpackJSON(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"flag":true,"order":{"amounts":["1","2"],"fee":{"memo":"hi","rate":"3"},"maker":"0x3535353535353535353535353535353535353535"}}`, result)
+
+	outputs, err := abi.ParseArguments("string,uint8")
+
+	assert.NoError(t, err)
+
+	data, err = outputs.PackJSON(`["Maker", 18]`)
+
+	assert.NoError(t, err)
+
+	result, err = outputs.UnpackJSON(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `["Maker","18"]`, result)
+}
+
+func TestUnpackMalformed(t *testing.T) {
+	outputs, err := abi.ParseArguments("string")
+
+	assert.NoError(t, err)
+
+	// offset beyond data
+	_, err = outputs.Unpack(unhex("0000000000000000000000000000000000000000000000000000000000000040"))
+
+	assert.Equal(t, abi.ErrData, err)
+
+	// length beyond data
+	_, err = outputs.Unpack(unhex(
+		"0000000000000000000000000000000000000000000000000000000000000020",
+		"00000000000000000000000000000000000000000000000000000000000000ff",
+	))
+
+	assert.Equal(t, abi.ErrData, err)
+
+	outputs, err = abi.ParseArguments("uint8,bool")
+
+	assert.NoError(t, err)
+
+	_, err = outputs.Unpack(unhex(
+		"0000000000000000000000000000000000000000000000000000000000000100",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	))
+
+	assert.Equal(t, abi.ErrData, err)
+
+	_, err = outputs.Unpack(unhex(
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+	))
+
+	assert.Equal(t, abi.ErrData, err)
+
+	outputs, err = abi.ParseArguments("uint256[]")
+
+	assert.NoError(t, err)
+
+	_, err = outputs.Unpack(unhex(
+		"0000000000000000000000000000000000000000000000000000000000000020",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	))
+
+	assert.Equal(t, abi.ErrData, err)
+}
@@ -0,0 +1,229 @@
+package abitest
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/abi"
+	"github.com/stretchr/testify/assert"
+)
+
+func words(data ...string) string {
+	return strings.Join(data, "")
+}
+
+func packSignature(t *testing.T, signature string, args string) string {
+	method, err := abi.NewMethod(signature)
+
+	assert.NoError(t, err)
+
+	arguments, err := method.Inputs.PackJSON(args)
+
+	assert.NoError(t, err)
+
+	return hex.EncodeToString(append(method.ID(), arguments...))
+}
+
+// examples from solidity abi specification
+func TestSolidityExamples(t *testing.T) {
+	assert.Equal(t, words(
+		"cdcd77c0",
+		"0000000000000000000000000000000000000000000000000000000000000045",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	), packSignature(t, "baz(uint32,bool)", `[69, true]`))
+
+	assert.Equal(t, words(
+		"fce353f6",
+		"6162630000000000000000000000000000000000000000000000000000000000",
+		"6465660000000000000000000000000000000000000000000000000000000000",
+	), packSignature(t, "bar(bytes3[2])", `[["0x616263", "0x646566"]]`))
+
+	assert.Equal(t, words(
+		"a5643bf2",
+		"0000000000000000000000000000000000000000000000000000000000000060",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"00000000000000000000000000000000000000000000000000000000000000a0",
+		"0000000000000000000000000000000000000000000000000000000000000004",
+		"6461766500000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+	), packSignature(t, "sam(bytes,bool,uint256[])", `["0x64617665", true, [1, 2, 3]]`))
+
+	assert.Equal(t, words(
+		"8be65246",
+		"0000000000000000000000000000000000000000000000000000000000000123",
+		"0000000000000000000000000000000000000000000000000000000000000080",
+		"3132333435363738393000000000000000000000000000000000000000000000",
+		"00000000000000000000000000000000000000000000000000000000000000e0",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000456",
+		"0000000000000000000000000000000000000000000000000000000000000789",
+		"000000000000000000000000000000000000000000000000000000000000000d",
+		"48656c6c6f2c20776f726c642100000000000000000000000000000000000000",
+	), packSignature(t, "f(uint256,uint32[],bytes10,bytes)", `["0x123", ["0x456", "0x789"], "0x31323334353637383930", "0x48656c6c6f2c20776f726c6421"]`))
+
+	assert.Equal(t, words(
+		"2289b18c",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000140",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"00000000000000000000000000000000000000000000000000000000000000a0",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"0000000000000000000000000000000000000000000000000000000000000060",
+		"00000000000000000000000000000000000000000000000000000000000000a0",
+		"00000000000000000000000000000000000000000000000000000000000000e0",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"6f6e650000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"74776f0000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000005",
+		"7468726565000000000000000000000000000000000000000000000000000000",
+	), packSignature(t, "g(uint256[][],string[])", `[[[1, 2], [3]], ["one", "two", "three"]]`))
+}
+
+func TestPackIntegers(t *testing.T) {
+	method, err := abi.NewMethod("f(int256,int8,uint8,int256)")
+
+	assert.NoError(t, err)
+
+	data, err := method.Inputs.PackJSON(`[-1, "-0x80", 255, "-57896044618658097711785492504343953926634992332820282019728792003956564819968"]`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, words(
+		strings.Repeat("ff", 32),
+		strings.Repeat("ff", 31)+"80",
+		strings.Repeat("00", 31)+"ff",
+		"80"+strings.Repeat("00", 31),
+	), hex.EncodeToString(data))
+
+	_, err = method.Inputs.PackJSON(`[0, -129, 0, 0]`)
+
+	assert.Error(t, err)
+
+	_, err = method.Inputs.PackJSON(`[0, 0, 256, 0]`)
+
+	assert.Error(t, err)
+
+	_, err = method.Inputs.PackJSON(`[0, 0, -1, 0]`)
+
+	assert.Error(t, err)
+}
+
+const tupleABI = `[
+	{"type":"constructor","inputs":[{"name":"owner","type":"address"}]},
+	{"type":"function","name":"submit","inputs":[
+		{"name":"order","type":"tuple","components":[
+			{"name":"maker","type":"address"},
+			{"name":"amounts","type":"uint256[]"},
+			{"name":"fee","type":"tuple","components":[{"name":"rate","type":"uint16"},{"name":"memo","type":"string"}]}
+		]},
+		{"name":"flag","type":"bool"}
+	],"outputs":[]},
+	{"type":"function","name":"set","inputs":[{"name":"value","type":"uint256"}]},
+	{"type":"function","name":"set","inputs":[{"name":"value","type":"string"}]},
+	{"type":"event","name":"Set","inputs":[{"name":"value","type":"uint256","indexed":true}]}
+]`
+
+func TestNestedTuple(t *testing.T) {
+	contract, err := abi.Parse([]byte(tupleABI))
+
+	assert.NoError(t, err)
+
+	method, err := contract.Method("submit")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "submit((address,uint256[],(uint16,string)),bool)", method.Signature())
+
+	positional, err := method.Inputs.PackJSON(`[["0x3535353535353535353535353535353535353535", [1, 2], [3, "hi"]], true]`)
+
+	assert.NoError(t, err)
+
+	named, err := method.Inputs.PackJSON(`{"order":{"maker":"0x3535353535353535353535353535353535353535","amounts":[1,2],"fee":{"rate":3,"memo":"hi"}},"flag":true}`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, positional, named)
+
+	assert.Equal(t, words(
+		// head: offset of dynamic tuple, flag
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		// order head: maker, offset of amounts, offset of fee
+		"0000000000000000000000003535353535353535353535353535353535353535",
+		"0000000000000000000000000000000000000000000000000000000000000060",
+		"00000000000000000000000000000000000000000000000000000000000000c0",
+		// amounts
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		// fee: rate, offset of memo, memo
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"6869000000000000000000000000000000000000000000000000000000000000",
+	), hex.EncodeToString(positional))
+
+	_, err = contract.Method("set")
+
+	assert.Equal(t, abi.ErrAmbiguous, err)
+
+	method, err = contract.Method("set(string)")
+
+	assert.NoError(t, err)
+
+	_, err = contract.Method("get")
+
+	assert.Equal(t, abi.ErrMethod, err)
+
+	data, err := contract.PackConstructor("0x3535353535353535353535353535353535353535")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0000000000000000000000003535353535353535353535353535353535353535", hex.EncodeToString(data))
+}
+
+func TestPackERC20(t *testing.T) {
+	method, err := abi.NewMethod("transfer(address,uint256)")
+
+	assert.NoError(t, err)
+
+	data, err := method.Pack("0x3535353535353535353535353535353535353535", "1000")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a9059cbb000000000000000000000000353535353535353535353535353535353535353500000000000000000000000000000000000000000000000000000000000003e8", hex.EncodeToString(data))
+
+	_, err = method.Pack("0x3535", "1000")
+
+	assert.Error(t, err)
+
+	_, err = method.Pack("0x3535353535353535353535353535353535353535")
+
+	assert.Error(t, err)
+}
+
+func TestTupleTypeSpaces(t *testing.T) {
+	method, err := abi.NewMethod("batch((uint256, address)[], (uint8 a, bool b) flags)")
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "batch((uint256,address)[],(uint8,bool))", method.Signature())
+	assert.Equal(t, "", method.Inputs[0].Name)
+	assert.Equal(t, "flags", method.Inputs[1].Name)
+	assert.Equal(t, "a", method.Inputs[1].Type.Components[0].Name)
+
+	arguments, err := abi.ParseArguments("(uint256, address)[] pairs, uint256")
+
+	if assert.NoError(t, err) && assert.Len(t, arguments, 2) {
+		assert.Equal(t, "pairs", arguments[0].Name)
+		assert.Equal(t, "(uint256,address)[]", arguments[0].Type.String())
+	}
+}
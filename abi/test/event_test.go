@@ -0,0 +1,71 @@
+package abitest
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/abi"
+	"github.com/stretchr/testify/assert"
+)
+
+const eventsABI = `[
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256"}]},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"Memo","inputs":[{"name":"text","type":"string","indexed":true},{"name":"","type":"string"},{"name":"amount","type":"int256"}]}
+]`
+
+func topic(data string) []byte {
+	return unhex(data)
+}
+
+func TestDecodeLog(t *testing.T) {
+	contract, err := abi.Parse([]byte(eventsABI))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(contract.Events))
+	assert.Equal(t, "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", hex.EncodeToString(contract.Events[0].ID()))
+
+	from := topic("0000000000000000000000003535353535353535353535353535353535353535")
+	to := topic("0000000000000000000000000000000000000000000000000000000000000001")
+	amount := topic("00000000000000000000000000000000000000000000000000000000000003e8")
+
+	log, err := contract.DecodeLog([][]byte{contract.Events[0].ID(), from, to}, amount)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Transfer", log.Event)
+	assert.Equal(t, "0x3535353535353535353535353535353535353535", log.Args["from"])
+	assert.Equal(t, "0x0000000000000000000000000000000000000001", log.Args["to"])
+	assert.Equal(t, "1000", log.Args["value"])
+
+	// erc721 Transfer shares topic0 and indexes token id
+	log, err = contract.DecodeLog([][]byte{contract.Events[0].ID(), from, to, amount}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", log.Args["tokenId"])
+
+	memo := contract.Events[2]
+
+	data, err := abi.Arguments{memo.Inputs[1], memo.Inputs[2]}.PackJSON(`["hello", -1]`)
+
+	assert.NoError(t, err)
+
+	log, err = contract.DecodeLog([][]byte{memo.ID(), amount}, data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Memo(string,string,int256)", log.Signature)
+	assert.Equal(t, "0x00000000000000000000000000000000000000000000000000000000000003e8", log.Args["text"])
+	assert.Equal(t, "hello", log.Args["arg1"])
+	assert.Equal(t, "-1", log.Args["amount"])
+
+	_, err = contract.DecodeLog([][]byte{contract.Events[0].ID(), from}, amount)
+
+	assert.Equal(t, abi.ErrEvent, err)
+
+	_, err = contract.DecodeLog([][]byte{amount}, nil)
+
+	assert.Equal(t, abi.ErrEvent, err)
+
+	_, err = contract.DecodeLog([][]byte{contract.Events[0].ID(), from, to}, nil)
+
+	assert.Equal(t, abi.ErrData, err)
+}
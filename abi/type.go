@@ -0,0 +1,315 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kind solidity type kind
+type Kind int
+
+// Type kinds
+const (
+	UintKind Kind = iota
+	IntKind
+	AddressKind
+	BoolKind
+	FixedBytesKind
+	BytesKind
+	StringKind
+	ArrayKind // fixed length array T[k]
+	SliceKind // dynamic array T[]
+	TupleKind
+)
+
+// Type parsed solidity abi type
+type Type struct {
+	Kind       Kind
+	Size       int   // bits of int and uint, bytes of fixed bytes, length of fixed array
+	Elem       *Type // element type of array and slice
+	Components Arguments
+}
+
+// Argument method input or output, event field
+type Argument struct {
+	Name    string
+	Type    *Type
+	Indexed bool
+}
+
+// Arguments argument list
+type Arguments []Argument
+
+type argumentJSON struct {
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Components []argumentJSON `json:"components"`
+	Indexed    bool           `json:"indexed"`
+}
+
+// NewType parse type like uint256, bytes32[], (address,uint256 amount)[2] or tuple with components
+func NewType(typ string, components Arguments) (*Type, error) {
+	typ = strings.TrimSpace(typ)
+
+	if strings.HasSuffix(typ, "]") {
+		start := strings.LastIndex(typ, "[")
+
+		if start < 0 {
+			return nil, fmt.Errorf("abi: invalid type %s", typ)
+		}
+
+		elem, err := NewType(typ[:start], components)
+
+		if err != nil {
+			return nil, err
+		}
+
+		length := typ[start+1 : len(typ)-1]
+
+		if length == "" {
+			return &Type{Kind: SliceKind, Elem: elem}, nil
+		}
+
+		size, err := strconv.Atoi(length)
+
+		if err != nil || size <= 0 {
+			return nil, fmt.Errorf("abi: invalid array length %s", typ)
+		}
+
+		return &Type{Kind: ArrayKind, Size: size, Elem: elem}, nil
+	}
+
+	if strings.HasPrefix(typ, "(") && strings.HasSuffix(typ, ")") {
+		components = nil
+
+		for _, component := range splitTypes(typ[1 : len(typ)-1]) {
+			var name string
+
+			// component can be named like "uint256 amount"
+			component, name = splitName(strings.TrimSpace(component))
+
+			componentType, err := NewType(component, nil)
+
+			if err != nil {
+				return nil, err
+			}
+
+			components = append(components, Argument{Name: name, Type: componentType})
+		}
+
+		return &Type{Kind: TupleKind, Components: components}, nil
+	}
+
+	switch {
+	case typ == "tuple":
+		return &Type{Kind: TupleKind, Components: components}, nil
+	case typ == "address":
+		return &Type{Kind: AddressKind, Size: 20}, nil
+	case typ == "bool":
+		return &Type{Kind: BoolKind}, nil
+	case typ == "string":
+		return &Type{Kind: StringKind}, nil
+	case typ == "bytes":
+		return &Type{Kind: BytesKind}, nil
+	case strings.HasPrefix(typ, "bytes"):
+		size, err := strconv.Atoi(typ[len("bytes"):])
+
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("abi: invalid type %s", typ)
+		}
+
+		return &Type{Kind: FixedBytesKind, Size: size}, nil
+	case strings.HasPrefix(typ, "uint"):
+		return newIntType(UintKind, typ, typ[len("uint"):])
+	case strings.HasPrefix(typ, "int"):
+		return newIntType(IntKind, typ, typ[len("int"):])
+	}
+
+	return nil, fmt.Errorf("abi: unsupported type %s", typ)
+}
+
+func newIntType(kind Kind, typ, bits string) (*Type, error) {
+	if bits == "" {
+		return &Type{Kind: kind, Size: 256}, nil
+	}
+
+	size, err := strconv.Atoi(bits)
+
+	if err != nil || size < 8 || size > 256 || size%8 != 0 {
+		return nil, fmt.Errorf("abi: invalid type %s", typ)
+	}
+
+	return &Type{Kind: kind, Size: size}, nil
+}
+
+// splitName split trailing component name, the name must follow the type outside
+// any parentheses, so spaces inside tuple types like "(uint256, address)[]" are kept
+func splitName(component string) (string, string) {
+	depth := 0
+
+	for i := len(component) - 1; i > 0; i-- {
+		switch component[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+		case ' ':
+			if depth == 0 {
+				return strings.TrimSpace(component[:i]), component[i+1:]
+			}
+		}
+	}
+
+	return component, ""
+}
+
+// splitTypes split comma separated types at top level of parentheses
+func splitTypes(types string) []string {
+	if strings.TrimSpace(types) == "" {
+		return nil
+	}
+
+	var result []string
+
+	depth, start := 0, 0
+
+	for i, c := range types {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, types[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(result, types[start:])
+}
+
+// String canonical type string used in signatures
+func (t *Type) String() string {
+	switch t.Kind {
+	case UintKind:
+		return fmt.Sprintf("uint%d", t.Size)
+	case IntKind:
+		return fmt.Sprintf("int%d", t.Size)
+	case AddressKind:
+		return "address"
+	case BoolKind:
+		return "bool"
+	case FixedBytesKind:
+		return fmt.Sprintf("bytes%d", t.Size)
+	case BytesKind:
+		return "bytes"
+	case StringKind:
+		return "string"
+	case ArrayKind:
+		return fmt.Sprintf("%s[%d]", t.Elem, t.Size)
+	case SliceKind:
+		return t.Elem.String() + "[]"
+	}
+
+	return "(" + t.Components.types() + ")"
+}
+
+// Dynamic check if type is encoded in the tail
+func (t *Type) Dynamic() bool {
+	switch t.Kind {
+	case BytesKind, StringKind, SliceKind:
+		return true
+	case ArrayKind:
+		return t.Elem.Dynamic()
+	case TupleKind:
+		for _, component := range t.Components {
+			if component.Type.Dynamic() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// headSize bytes taken in the head of the enclosing sequence
+func (t *Type) headSize() int {
+	if t.Dynamic() {
+		return 32
+	}
+
+	switch t.Kind {
+	case ArrayKind:
+		return t.Size * t.Elem.headSize()
+	case TupleKind:
+		size := 0
+
+		for _, component := range t.Components {
+			size += component.Type.headSize()
+		}
+
+		return size
+	}
+
+	return 32
+}
+
+// UnmarshalJSON implement json.Unmarshaler
+func (argument *Argument) UnmarshalJSON(data []byte) error {
+	var argJSON argumentJSON
+
+	if err := json.Unmarshal(data, &argJSON); err != nil {
+		return err
+	}
+
+	parsed, err := argJSON.argument()
+
+	if err != nil {
+		return err
+	}
+
+	*argument = parsed
+
+	return nil
+}
+
+func (argJSON argumentJSON) argument() (Argument, error) {
+	var components Arguments
+
+	for _, component := range argJSON.Components {
+		parsed, err := component.argument()
+
+		if err != nil {
+			return Argument{}, err
+		}
+
+		components = append(components, parsed)
+	}
+
+	typ, err := NewType(argJSON.Type, components)
+
+	if err != nil {
+		return Argument{}, err
+	}
+
+	return Argument{
+		Name:    argJSON.Name,
+		Type:    typ,
+		Indexed: argJSON.Indexed,
+	}, nil
+}
+
+// types comma separated canonical types
+func (arguments Arguments) types() string {
+	types := make([]string, 0, len(arguments))
+
+	for _, argument := range arguments {
+		types = append(types, argument.Type.String())
+	}
+
+	return strings.Join(types, ",")
+}
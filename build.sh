@@ -1,2 +1,2 @@
-gomobile bind -target ios -o ./build/mobilesdk.framework github.com/inwecrypto/mobilesdk/neomobile github.com/inwecrypto/mobilesdk/ethmobile
-gomobile bind -target android -o ./build/mobilesdk.aar github.com/inwecrypto/mobilesdk/neomobile github.com/inwecrypto/mobilesdk/ethmobile
\ No newline at end of file
+gomobile bind -target ios -o ./build/mobilesdk.framework github.com/inwecrypto/mobilesdk/neomobile github.com/inwecrypto/mobilesdk/ethmobile github.com/inwecrypto/mobilesdk/hdmobile github.com/inwecrypto/mobilesdk/keystoremobile
+gomobile bind -target android -o ./build/mobilesdk.aar github.com/inwecrypto/mobilesdk/neomobile github.com/inwecrypto/mobilesdk/ethmobile github.com/inwecrypto/mobilesdk/hdmobile github.com/inwecrypto/mobilesdk/keystoremobile
\ No newline at end of file
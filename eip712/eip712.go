@@ -0,0 +1,283 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/inwecrypto/mobilesdk/abi"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// DomainType name of domain struct type
+const DomainType = "EIP712Domain"
+
+// domainFields eip-712 domain fields in canonical order
+var domainFields = []Type{
+	{"name", "string"},
+	{"version", "string"},
+	{"chainId", "uint256"},
+	{"verifyingContract", "address"},
+	{"salt", "bytes32"},
+}
+
+// Type struct member
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData eth_signTypedData_v4 json
+type TypedData struct {
+	Types       map[string][]Type      `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      map[string]interface{} `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+// Parse parse typed data json, EIP712Domain type is derived from domain fields if missing
+func Parse(data []byte) (*TypedData, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	decoder.UseNumber()
+
+	var typedData *TypedData
+
+	if err := decoder.Decode(&typedData); err != nil {
+		return nil, err
+	}
+
+	if typedData == nil || typedData.PrimaryType == "" {
+		return nil, fmt.Errorf("eip712: primaryType is required")
+	}
+
+	if typedData.Types == nil {
+		typedData.Types = make(map[string][]Type)
+	}
+
+	if _, ok := typedData.Types[DomainType]; !ok {
+		var fields []Type
+
+		for _, field := range domainFields {
+			if _, ok := typedData.Domain[field.Name]; ok {
+				fields = append(fields, field)
+			}
+		}
+
+		typedData.Types[DomainType] = fields
+	}
+
+	if _, ok := typedData.Types[typedData.PrimaryType]; !ok {
+		return nil, fmt.Errorf("eip712: primary type %s is not defined", typedData.PrimaryType)
+	}
+
+	return typedData, nil
+}
+
+// baseType strip array suffixes, Person[][2] -> Person
+func baseType(typ string) string {
+	if index := strings.Index(typ, "["); index >= 0 {
+		return typ[:index]
+	}
+
+	return typ
+}
+
+// dependencies struct types referenced by primary, including itself
+func (typedData *TypedData) dependencies(primary string, found map[string]bool) {
+	primary = baseType(primary)
+
+	if found[primary] {
+		return
+	}
+
+	if _, ok := typedData.Types[primary]; !ok {
+		return
+	}
+
+	found[primary] = true
+
+	for _, field := range typedData.Types[primary] {
+		typedData.dependencies(field.Type, found)
+	}
+}
+
+// EncodeType encode type with referenced struct types sorted by name appended,
+// like Mail(Person from,Person to,string contents)Person(string name,address wallet)
+func (typedData *TypedData) EncodeType(primary string) (string, error) {
+	if _, ok := typedData.Types[primary]; !ok {
+		return "", fmt.Errorf("eip712: type %s is not defined", primary)
+	}
+
+	found := make(map[string]bool)
+
+	typedData.dependencies(primary, found)
+
+	delete(found, primary)
+
+	deps := make([]string, 0, len(found))
+
+	for dep := range found {
+		deps = append(deps, dep)
+	}
+
+	sort.Strings(deps)
+
+	var buffer bytes.Buffer
+
+	for _, typ := range append([]string{primary}, deps...) {
+		fields := make([]string, 0, len(typedData.Types[typ]))
+
+		for _, field := range typedData.Types[typ] {
+			fields = append(fields, field.Type+" "+field.Name)
+		}
+
+		buffer.WriteString(typ + "(" + strings.Join(fields, ",") + ")")
+	}
+
+	return buffer.String(), nil
+}
+
+// TypeHash keccak256 of encoded type
+func (typedData *TypedData) TypeHash(primary string) ([]byte, error) {
+	encoded, err := typedData.EncodeType(primary)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ethtx.Keccak256([]byte(encoded)), nil
+}
+
+// HashStruct keccak256(typeHash || encodeData(data))
+func (typedData *TypedData) HashStruct(primary string, data map[string]interface{}) ([]byte, error) {
+	typeHash, err := typedData.TypeHash(primary)
+
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := [][]byte{typeHash}
+
+	for _, field := range typedData.Types[primary] {
+		value, ok := data[field.Name]
+
+		if !ok {
+			return nil, fmt.Errorf("eip712: %s.%s is missing", primary, field.Name)
+		}
+
+		word, err := typedData.encodeValue(field.Type, value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		encoded = append(encoded, word)
+	}
+
+	return ethtx.Keccak256(encoded...), nil
+}
+
+// encodeValue encode field value into 32 bytes
+func (typedData *TypedData) encodeValue(typ string, value interface{}) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		items := reflect.ValueOf(value)
+
+		if value == nil || items.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("eip712: %s value must be array", typ)
+		}
+
+		elem := typ[:strings.LastIndex(typ, "[")]
+
+		if length := typ[len(elem)+1 : len(typ)-1]; length != "" && length != fmt.Sprint(items.Len()) {
+			return nil, fmt.Errorf("eip712: %s value has %d elements", typ, items.Len())
+		}
+
+		encoded := make([][]byte, 0, items.Len())
+
+		for i := 0; i < items.Len(); i++ {
+			word, err := typedData.encodeValue(elem, items.Index(i).Interface())
+
+			if err != nil {
+				return nil, err
+			}
+
+			encoded = append(encoded, word)
+		}
+
+		return ethtx.Keccak256(encoded...), nil
+	}
+
+	if _, ok := typedData.Types[typ]; ok {
+		data, ok := value.(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("eip712: %s value must be object", typ)
+		}
+
+		return typedData.HashStruct(typ, data)
+	}
+
+	switch typ {
+	case "string":
+		text, ok := value.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("eip712: string value expected, got %v", value)
+		}
+
+		return ethtx.Keccak256([]byte(text)), nil
+	case "bytes":
+		text, ok := value.(string)
+
+		if !ok || !strings.HasPrefix(text, "0x") {
+			return nil, fmt.Errorf("eip712: bytes value must be 0x prefixed hex, got %v", value)
+		}
+
+		data, err := hex.DecodeString(text[2:])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return ethtx.Keccak256(data), nil
+	}
+
+	atomic, err := abi.NewType(typ, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if atomic.Dynamic() || atomic.Kind == abi.TupleKind {
+		return nil, fmt.Errorf("eip712: type %s is not defined", typ)
+	}
+
+	return abi.Arguments{{Type: atomic}}.Pack(value)
+}
+
+// DomainSeparator hashStruct of domain
+func (typedData *TypedData) DomainSeparator() ([]byte, error) {
+	return typedData.HashStruct(DomainType, typedData.Domain)
+}
+
+// Hash signing hash: keccak256(0x19 0x01 || domainSeparator || hashStruct(message))
+func (typedData *TypedData) Hash() ([]byte, error) {
+	domainSeparator, err := typedData.DomainSeparator()
+
+	if err != nil {
+		return nil, err
+	}
+
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ethtx.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash), nil
+}
@@ -0,0 +1,189 @@
+package eip712test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/inwecrypto/ethgo/keystore"
+	"github.com/inwecrypto/mobilesdk/eip712"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/stretchr/testify/assert"
+)
+
+// example from eip-712 specification
+const mailJSON = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestMailExample(t *testing.T) {
+	typedData, err := eip712.Parse([]byte(mailJSON))
+
+	assert.NoError(t, err)
+
+	encodeType, err := typedData.EncodeType("Mail")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encodeType)
+
+	typeHash, err := typedData.TypeHash("Mail")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", hex.EncodeToString(typeHash))
+
+	domainSeparator, err := typedData.DomainSeparator()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	messageHash, err := typedData.HashStruct("Mail", typedData.Message)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(messageHash))
+
+	hash, err := typedData.Hash()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hash))
+
+	key, err := keystore.KeyFromPrivateKey(ethtx.Keccak256([]byte("cow")))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", key.Address)
+
+	signature, err := ethtx.SignHash(hash, key.PrivateKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d"+
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562"+"1c", hex.EncodeToString(signature))
+}
+
+const arraysJSON = `{
+	"types": {
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallets", "type": "address[]"}
+		],
+		"Group": [
+			{"name": "name", "type": "string"},
+			{"name": "members", "type": "Person[]"},
+			{"name": "logo", "type": "bytes"},
+			{"name": "tag", "type": "bytes4"},
+			{"name": "scores", "type": "int8[2]"}
+		]
+	},
+	"primaryType": "Group",
+	"domain": {"name": "Groups", "chainId": "0x1"},
+	"message": {
+		"name": "A",
+		"members": [
+			{"name": "Cow", "wallets": ["0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"]},
+			{"name": "Bob", "wallets": []}
+		],
+		"logo": "0x0102",
+		"tag": "0xdeadbeef",
+		"scores": [-1, 2]
+	}
+}`
+
+func word(hexData string) []byte {
+	data, _ := hex.DecodeString(hexData)
+
+	return data
+}
+
+func TestArraysAndNestedStructs(t *testing.T) {
+	typedData, err := eip712.Parse([]byte(arraysJSON))
+
+	assert.NoError(t, err)
+
+	encodeType, err := typedData.EncodeType("Group")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Group(string name,Person[] members,bytes logo,bytes4 tag,int8[2] scores)Person(string name,address[] wallets)", encodeType)
+
+	// domain type is derived from the domain fields
+	domainType, err := typedData.EncodeType(eip712.DomainType)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EIP712Domain(string name,uint256 chainId)", domainType)
+
+	personType := ethtx.Keccak256([]byte("Person(string name,address[] wallets)"))
+
+	cow := ethtx.Keccak256(personType, ethtx.Keccak256([]byte("Cow")),
+		ethtx.Keccak256(word("000000000000000000000000CD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")))
+	bob := ethtx.Keccak256(personType, ethtx.Keccak256([]byte("Bob")), ethtx.Keccak256())
+
+	expected := ethtx.Keccak256(
+		ethtx.Keccak256([]byte(encodeType)),
+		ethtx.Keccak256([]byte("A")),
+		ethtx.Keccak256(cow, bob),
+		ethtx.Keccak256([]byte{1, 2}),
+		word("deadbeef00000000000000000000000000000000000000000000000000000000"),
+		ethtx.Keccak256(
+			word("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+			word("0000000000000000000000000000000000000000000000000000000000000002")),
+	)
+
+	messageHash, err := typedData.HashStruct("Group", typedData.Message)
+
+	assert.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(expected), hex.EncodeToString(messageHash))
+}
+
+func TestInvalidTypedData(t *testing.T) {
+	_, err := eip712.Parse([]byte(`{"types":{},"primaryType":"Mail","domain":{},"message":{}}`))
+
+	assert.Error(t, err)
+
+	typedData, err := eip712.Parse([]byte(`{"types":{"Mail":[{"name":"to","type":"Person"}]},"primaryType":"Mail","domain":{},"message":{"to":{}}}`))
+
+	assert.NoError(t, err)
+
+	_, err = typedData.Hash()
+
+	assert.Error(t, err)
+
+	typedData, err = eip712.Parse([]byte(`{"types":{"Mail":[{"name":"to","type":"address"}]},"primaryType":"Mail","domain":{},"message":{}}`))
+
+	assert.NoError(t, err)
+
+	_, err = typedData.Hash()
+
+	assert.Error(t, err)
+
+	typedData, err = eip712.Parse([]byte(`{"types":{"Mail":[{"name":"ids","type":"uint8[2]"}]},"primaryType":"Mail","domain":{},"message":{"ids":[1]}}`))
+
+	assert.NoError(t, err)
+
+	_, err = typedData.Hash()
+
+	assert.Error(t, err)
+}
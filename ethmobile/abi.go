@@ -0,0 +1,158 @@
+package ethmobile
+
+import (
+	"encoding/hex"
+
+	"github.com/inwecrypto/ethgo"
+	"github.com/inwecrypto/mobilesdk/abi"
+)
+
+// ABI contract json abi
+type ABI struct {
+	abi *abi.ABI
+}
+
+// NewABI load contract json abi
+func NewABI(abiJSON string) (*ABI, error) {
+	contract, err := abi.Parse([]byte(abiJSON))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ABI{
+		abi: contract,
+	}, nil
+}
+
+// Encode encode call data of method, method is name or signature like transfer(address,uint256)
+// when name is overloaded, args is json array or object keyed by argument names
+func (contract *ABI) Encode(method string, args string) (string, error) {
+	found, err := contract.abi.Method(method)
+
+	if err != nil {
+		return "", err
+	}
+
+	return encodeCall(found, args)
+}
+
+// EncodeConstructor encode constructor arguments, result can be passed to DeployContract
+func (contract *ABI) EncodeConstructor(args string) (string, error) {
+	var inputs abi.Arguments
+
+	if contract.abi.Constructor != nil {
+		inputs = contract.abi.Constructor.Inputs
+	}
+
+	data, err := inputs.PackJSON(args)
+
+	if err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// MethodID get 4 bytes selector of method
+func (contract *ABI) MethodID(method string) (string, error) {
+	found, err := contract.abi.Method(method)
+
+	if err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(found.ID()), nil
+}
+
+// EncodeCall encode call data of method signature like transfer(address,uint256) without json abi,
+// arguments are unnamed so args must be json array
+func EncodeCall(signature string, args string) (string, error) {
+	method, err := abi.NewMethod(signature)
+
+	if err != nil {
+		return "", err
+	}
+
+	return encodeCall(method, args)
+}
+
+func encodeCall(method *abi.Method, args string) (string, error) {
+	data, err := method.Inputs.PackJSON(args)
+
+	if err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(append(method.ID(), data...)), nil
+}
+
+// CallContract create contract call tx with call data encoded by ABI.Encode
+func (wallet *Wallet) CallContract(chainID, contract, nonce, amount, data, gasPrice, gasLimits string) (*SignedTx, error) {
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := readHex(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createTxData(chainID, contract, nonce, gasPrice, gasLimits, (*ethgo.Value)(amountBigInt), codes)
+}
+
+// CallContractEIP1559 create contract call tx with eip-1559 dynamic fee tx
+func (wallet *Wallet) CallContractEIP1559(chainID, contract, nonce, amount, data, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := readHex(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createDynamicFeeTxData(chainID, contract, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, (*ethgo.Value)(amountBigInt), codes)
+}
+
+// Decode decode eth_call result of method into json object keyed by output names, or
+// json array when any output is unnamed
+func (contract *ABI) Decode(method string, result string) (string, error) {
+	found, err := contract.abi.Method(method)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := readHex(result)
+
+	if err != nil {
+		return "", err
+	}
+
+	return found.Outputs.UnpackJSON(data)
+}
+
+// DecodeResult decode eth_call result with output types like "string,uint8" or "(int256[],int256[])",
+// returns json array
+func DecodeResult(types string, result string) (string, error) {
+	outputs, err := abi.ParseArguments(types)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := readHex(result)
+
+	if err != nil {
+		return "", err
+	}
+
+	return outputs.UnpackJSON(data)
+}
@@ -0,0 +1,144 @@
+package ethmobile
+
+import (
+	"math/big"
+
+	"github.com/inwecrypto/ethgo"
+	"github.com/inwecrypto/ethgo/erc20"
+	"github.com/inwecrypto/ethgo/erc721"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// TransferEIP2930 transfer eth to target address with eip-2930 access list tx,
+// accessList is json access list, empty means no access list
+func (wallet *Wallet) TransferEIP2930(chainID, nonce, to, amount, gasPrice, gasLimits, accessList string) (*SignedTx, error) {
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createAccessListTxData(chainID, to, nonce, gasPrice, gasLimits, accessList, (*ethgo.Value)(amountBigInt), nil)
+}
+
+// TransferERC20EIP2930 transfer erc20 token to target address with eip-2930 access list tx
+func (wallet *Wallet) TransferERC20EIP2930(chainID, contract, nonce, to, amount, gasPrice, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc20.Transfer(to, amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createAccessListTxData(chainID, contract, nonce, gasPrice, gasLimits, accessList, nil, codes)
+}
+
+// ApproveEIP2930 erc20 approve with eip-2930 access list tx
+func (wallet *Wallet) ApproveEIP2930(chainID, contract, nonce, to, value, gasPrice, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc20.Approve(to, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createAccessListTxData(chainID, contract, nonce, gasPrice, gasLimits, accessList, nil, codes)
+}
+
+// TransferFromEIP2930 erc20 transferFrom with eip-2930 access list tx
+func (wallet *Wallet) TransferFromEIP2930(chainID, contract, nonce, from, to, value, gasPrice, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc20.TransferFrom(from, to, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createAccessListTxData(chainID, contract, nonce, gasPrice, gasLimits, accessList, nil, codes)
+}
+
+// TransferLandEIP2930 decentraland land transfer with eip-2930 access list tx
+func (wallet *Wallet) TransferLandEIP2930(chainID, contract, nonce, to, x, y, gasPrice, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc721.TransferLand(to, x, y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createAccessListTxData(chainID, contract, nonce, gasPrice, gasLimits, accessList, nil, codes)
+}
+
+// NewRedPacketEIP2930 create red packet with eip-2930 access list tx
+func (wallet *Wallet) NewRedPacketEIP2930(chainID, redcontract, nonce, erc20contract, tokenId, from, amount, value, count, command, gasPrice, gasLimits, accessList string) (*SignedTx, error) {
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := erc721.NewRedPacket(tokenId, erc20contract, from, value, count, command)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createAccessListTxData(chainID, redcontract, nonce, gasPrice, gasLimits, accessList, (*ethgo.Value)(amountBigInt), codes)
+}
+
+// createAccessListTxData create signed eip-2930 tx, returns signed tx with metadata
+func (wallet *Wallet) createAccessListTxData(chainID, to, nonce, gasPrice, gasLimits, accessList string, amount *ethgo.Value, codes []byte) (*SignedTx, error) {
+	recipient, err := readRecipient(to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	chainIDBigInt, err := readBigint(chainID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonceBigInt, err := readBigint(nonce)
+
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceBigInt, err := readBigint(gasPrice)
+
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimitsBigInt, err := readBigint(gasLimits)
+
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := ethtx.ParseAccessList(accessList)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawTx := ethtx.NewAccessListTx(
+		chainIDBigInt,
+		nonceBigInt.Uint64(),
+		recipient,
+		(*big.Int)(amount),
+		gasPriceBigInt,
+		gasLimitsBigInt,
+		codes,
+		list)
+
+	if err := rawTx.Sign(wallet.key.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	data, err := rawTx.Encode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.newSignedTx(data, chainIDBigInt, nonceBigInt.Uint64(), gasLimitsBigInt, gasPriceBigInt, recipient)
+}
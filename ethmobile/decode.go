@@ -0,0 +1,112 @@
+package ethmobile
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// decodedTx json view of decoded transaction, quantities are hex like eth rpc
+type decodedTx struct {
+	Type                 string           `json:"type"`
+	ChainID              string           `json:"chainId,omitempty"`
+	Nonce                string           `json:"nonce"`
+	GasPrice             string           `json:"gasPrice,omitempty"`
+	MaxPriorityFeePerGas string           `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerGas         string           `json:"maxFeePerGas,omitempty"`
+	Gas                  string           `json:"gas"`
+	To                   string           `json:"to,omitempty"`
+	Value                string           `json:"value"`
+	Input                string           `json:"input"`
+	AccessList           ethtx.AccessList `json:"accessList,omitempty"`
+	Signed               bool             `json:"signed"`
+	Hash                 string           `json:"hash,omitempty"`
+	From                 string           `json:"from,omitempty"`
+	ContractAddress      string           `json:"contractAddress,omitempty"`
+	V                    string           `json:"v,omitempty"`
+	R                    string           `json:"r,omitempty"`
+	S                    string           `json:"s,omitempty"`
+	Call                 *ethtx.Call      `json:"call,omitempty"`
+}
+
+// DecodeTransaction decode hex encoded legacy, eip-155, eip-2930 or eip-1559 raw tx into json,
+// sender is recovered for signed tx and known erc20, erc721, decentraland and red packet
+// calls are decoded into call field
+func DecodeTransaction(rawTx string) (string, error) {
+	raw, err := readHex(rawTx)
+
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := ethtx.DecodeTransaction(raw)
+
+	if err != nil {
+		return "", err
+	}
+
+	result := &decodedTx{
+		Type:                 hexBigint(big.NewInt(int64(tx.Type))),
+		ChainID:              hexBigint(tx.ChainID),
+		Nonce:                hexBigint(new(big.Int).SetUint64(tx.Nonce)),
+		GasPrice:             hexBigint(tx.GasPrice),
+		MaxPriorityFeePerGas: hexBigint(tx.GasTipCap),
+		MaxFeePerGas:         hexBigint(tx.GasFeeCap),
+		Gas:                  hexBigint(tx.GasLimit),
+		Value:                hexBigint(tx.Value),
+		Input:                "0x" + hex.EncodeToString(tx.Data),
+		AccessList:           tx.AccessList,
+		Signed:               tx.Signed(),
+	}
+
+	if tx.To != nil {
+		result.To = ethtx.ChecksumAddress(tx.To[:])
+	}
+
+	if tx.Signed() {
+		result.Hash = "0x" + hex.EncodeToString(tx.Hash())
+		result.V = hexBigint(tx.V)
+		result.R = hexBigint(tx.R)
+		result.S = hexBigint(tx.S)
+
+		if result.From, err = tx.Sender(); err != nil {
+			return "", err
+		}
+
+		if tx.To == nil {
+			sender, _ := ethtx.ParseAddress(result.From)
+
+			address, err := ethtx.CreateAddress(*sender, tx.Nonce)
+
+			if err != nil {
+				return "", err
+			}
+
+			result.ContractAddress = ethtx.ChecksumAddress(address[:])
+		}
+	}
+
+	if tx.To != nil {
+		if call, err := ethtx.DecodeCallData(tx.Data); err == nil {
+			result.Call = call
+		}
+	}
+
+	data, err := json.Marshal(result)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func hexBigint(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return "0x" + value.Text(16)
+}
@@ -0,0 +1,135 @@
+package ethmobile
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/inwecrypto/ethgo"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// DeployContract create contract creation tx, SignedTx.ContractAddress is the address
+// of the contract to be created. constructorArgs is hex encoded abi
+// arguments appended to bytecode, can be empty
+func (wallet *Wallet) DeployContract(chainID, nonce, bytecode, constructorArgs, amount, gasPrice, gasLimits string) (*SignedTx, error) {
+	codes, err := readDeployCode(bytecode, constructorArgs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.signLegacyTx(chainID, nil, nonce, gasPrice, gasLimits, (*ethgo.Value)(amountBigInt), codes)
+}
+
+// DeployContractEIP1559 create contract creation tx with eip-1559 dynamic fee tx
+func (wallet *Wallet) DeployContractEIP1559(chainID, nonce, bytecode, constructorArgs, amount, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := readDeployCode(bytecode, constructorArgs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.signDynamicFeeTx(chainID, nil, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, (*ethgo.Value)(amountBigInt), codes)
+}
+
+// PredictContractAddress address of contract deployed by sender with nonce
+func PredictContractAddress(sender, nonce string) (string, error) {
+	senderAddress, err := ethtx.ParseAddress(sender)
+
+	if err != nil {
+		return "", err
+	}
+
+	if senderAddress == nil {
+		return "", fmt.Errorf("sender address is required")
+	}
+
+	nonceBigInt, err := readBigint(nonce)
+
+	if err != nil {
+		return "", err
+	}
+
+	address, err := ethtx.CreateAddress(*senderAddress, nonceBigInt.Uint64())
+
+	if err != nil {
+		return "", err
+	}
+
+	return ethtx.ChecksumAddress(address[:]), nil
+}
+
+// PredictCreate2Address address of contract deployed by factory with CREATE2,
+// salt is 32 bytes hex, initCode is hex encoded creation code with constructor args
+func PredictCreate2Address(factory, salt, initCode string) (string, error) {
+	factoryAddress, err := ethtx.ParseAddress(factory)
+
+	if err != nil {
+		return "", err
+	}
+
+	if factoryAddress == nil {
+		return "", fmt.Errorf("factory address is required")
+	}
+
+	saltBytes, err := readHex(salt)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(saltBytes) != 32 {
+		return "", fmt.Errorf("salt must be 32 bytes")
+	}
+
+	code, err := readHex(initCode)
+
+	if err != nil {
+		return "", err
+	}
+
+	var saltArray [32]byte
+
+	copy(saltArray[:], saltBytes)
+
+	address := ethtx.Create2Address(*factoryAddress, saltArray, code)
+
+	return ethtx.ChecksumAddress(address[:]), nil
+}
+
+func readDeployCode(bytecode, constructorArgs string) ([]byte, error) {
+	code, err := readHex(bytecode)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(code) == 0 {
+		return nil, fmt.Errorf("contract bytecode is required")
+	}
+
+	args, err := readHex(constructorArgs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(code, args...), nil
+}
+
+func readHex(source string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(source, "0x"))
+}
@@ -0,0 +1,161 @@
+package ethmobile
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/inwecrypto/ethgo"
+	"github.com/inwecrypto/ethgo/erc20"
+	"github.com/inwecrypto/ethgo/erc721"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// TransferEIP1559 transfer eth to target address with eip-1559 dynamic fee tx,
+// accessList is optional eip-2930 json access list
+func (wallet *Wallet) TransferEIP1559(chainID, nonce, to, amount, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createDynamicFeeTxData(chainID, to, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, (*ethgo.Value)(amountBigInt), nil)
+}
+
+// TransferERC20EIP1559 transfer erc20 token to target address with eip-1559 dynamic fee tx
+func (wallet *Wallet) TransferERC20EIP1559(chainID, contract, nonce, to, amount, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc20.Transfer(to, amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createDynamicFeeTxData(chainID, contract, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, nil, codes)
+}
+
+// ApproveEIP1559 erc20 approve with eip-1559 dynamic fee tx
+func (wallet *Wallet) ApproveEIP1559(chainID, contract, nonce, to, value, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc20.Approve(to, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createDynamicFeeTxData(chainID, contract, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, nil, codes)
+}
+
+// TransferFromEIP1559 erc20 transferFrom with eip-1559 dynamic fee tx
+func (wallet *Wallet) TransferFromEIP1559(chainID, contract, nonce, from, to, value, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc20.TransferFrom(from, to, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createDynamicFeeTxData(chainID, contract, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, nil, codes)
+}
+
+// TransferLandEIP1559 decentraland land transfer with eip-1559 dynamic fee tx
+func (wallet *Wallet) TransferLandEIP1559(chainID, contract, nonce, to, x, y, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	codes, err := erc721.TransferLand(to, x, y)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createDynamicFeeTxData(chainID, contract, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, nil, codes)
+}
+
+// NewRedPacketEIP1559 create red packet with eip-1559 dynamic fee tx
+func (wallet *Wallet) NewRedPacketEIP1559(chainID, redcontract, nonce, erc20contract, tokenId, from, amount, value, count, command, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string) (*SignedTx, error) {
+	amountBigInt, err := readBigint(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := erc721.NewRedPacket(tokenId, erc20contract, from, value, count, command)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.createDynamicFeeTxData(chainID, redcontract, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, (*ethgo.Value)(amountBigInt), codes)
+}
+
+// createDynamicFeeTxData create signed eip-1559 tx to recipient, returns signed tx with metadata
+func (wallet *Wallet) createDynamicFeeTxData(chainID, to, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string, amount *ethgo.Value, codes []byte) (*SignedTx, error) {
+	recipient, err := readRecipient(to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.signDynamicFeeTx(chainID, recipient, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList, amount, codes)
+}
+
+// signDynamicFeeTx create signed eip-1559 tx, nil recipient means contract creation
+func (wallet *Wallet) signDynamicFeeTx(chainID string, recipient *[20]byte, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimits, accessList string, amount *ethgo.Value, codes []byte) (*SignedTx, error) {
+	chainIDBigInt, err := readBigint(chainID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonceBigInt, err := readBigint(nonce)
+
+	if err != nil {
+		return nil, err
+	}
+
+	gasTipCap, err := readBigint(maxPriorityFeePerGas)
+
+	if err != nil {
+		return nil, err
+	}
+
+	gasFeeCap, err := readBigint(maxFeePerGas)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		return nil, fmt.Errorf("maxPriorityFeePerGas %s is higher than maxFeePerGas %s", gasTipCap, gasFeeCap)
+	}
+
+	gasLimitsBigInt, err := readBigint(gasLimits)
+
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := ethtx.ParseAccessList(accessList)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawTx := ethtx.NewDynamicFeeTx(
+		chainIDBigInt,
+		nonceBigInt.Uint64(),
+		recipient,
+		(*big.Int)(amount),
+		gasTipCap,
+		gasFeeCap,
+		gasLimitsBigInt,
+		codes,
+		list)
+
+	if err := rawTx.Sign(wallet.key.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	data, err := rawTx.Encode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.newSignedTx(data, chainIDBigInt, nonceBigInt.Uint64(), gasLimitsBigInt, gasFeeCap, recipient)
+}
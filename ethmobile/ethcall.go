@@ -6,6 +6,7 @@ import (
 	"github.com/inwecrypto/ethgo/erc20"
 	"github.com/inwecrypto/ethgo/erc721"
 	"github.com/inwecrypto/ethgo/rpc"
+	"github.com/inwecrypto/mobilesdk/ethtx"
 )
 
 type EthCall struct {
@@ -119,6 +120,8 @@ func (self *EthCall) OwnerOf(contract string, value string) (string, error) {
 	return self.Call(contract, data)
 }
 
+// RedPacketTaxCost call getTaxCost(), no Decode helper until the output types are
+// taken from the deployed contract's verified abi
 func (self *EthCall) RedPacketTaxCost(contract string) (string, error) {
 	data := erc721.TaxCost()
 
@@ -131,14 +134,51 @@ func (self *EthCall) RedPacketMaxCount(contract string) (string, error) {
 	return self.Call(contract, data)
 }
 
+// RedPacketOpenDetail call getRedPacketOpenDetail(uint256), no Decode helper until the
+// output types are taken from the deployed contract's verified abi
 func (self *EthCall) RedPacketOpenDetail(contract string, value string) (string, error) {
 	data := erc721.GetRedPacketOpenDetail(value)
 
 	return self.Call(contract, data)
 }
 
+// RedPacketStatus call getRedPacketStatus(uint256), no Decode helper until the output
+// types are taken from the deployed contract's verified abi
 func (self *EthCall) RedPacketStatus(contract string, value string) (string, error) {
 	data := erc721.GetRedPacketStatus(value)
 
 	return self.Call(contract, data)
 }
+
+// CreateAccessList call eth_createAccessList of node at endpoint with pending block,
+// returns json {"accessList":[...],"gasUsed":"0x.."}, accessList can be passed to
+// EIP2930 or EIP1559 builders
+func (self *EthCall) CreateAccessList(endpoint, from, to, value, data string) (string, error) {
+	site := &rpc.CallSite{
+		From:  from,
+		To:    to,
+		Value: value,
+		Data:  data,
+	}
+
+	list, gasUsed, err := ethtx.CreateAccessList(endpoint, site, "pending")
+
+	if err != nil {
+		return "", err
+	}
+
+	if list == nil {
+		list = ethtx.AccessList{}
+	}
+
+	b, err := json.Marshal(&ethtx.AccessListResult{
+		AccessList: list,
+		GasUsed:    "0x" + gasUsed.Text(16),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
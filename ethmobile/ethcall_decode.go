@@ -0,0 +1,183 @@
+package ethmobile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/inwecrypto/mobilesdk/abi"
+)
+
+// DecodeDecimals decode Decimals result
+func (self *EthCall) DecodeDecimals(result string) (string, error) {
+	return decodeSingle("uint8", result)
+}
+
+// DecodeTotalSupply decode TotalSupply result as decimal string
+func (self *EthCall) DecodeTotalSupply(result string) (string, error) {
+	return decodeSingle("uint256", result)
+}
+
+// DecodeBalanceOf decode BalanceOf result as decimal string
+func (self *EthCall) DecodeBalanceOf(result string) (string, error) {
+	return decodeSingle("uint256", result)
+}
+
+// DecodeName decode Name result, legacy tokens like MKR return bytes32 instead of string
+func (self *EthCall) DecodeName(result string) (string, error) {
+	return decodeText(result)
+}
+
+// DecodeLandDecodeTokenId decode LandDecodeTokenId result into json {"x":"..","y":".."}
+func (self *EthCall) DecodeLandDecodeTokenId(result string) (string, error) {
+	return decodeJSON("(int256 x,int256 y)", result)
+}
+
+// DecodeLandEncodeTokenId decode LandEncodeTokenId result as decimal string
+func (self *EthCall) DecodeLandEncodeTokenId(result string) (string, error) {
+	return decodeSingle("uint256", result)
+}
+
+// DecodeLandData decode LandData result
+func (self *EthCall) DecodeLandData(result string) (string, error) {
+	return decodeText(result)
+}
+
+// DecodeLandOf decode LandOf result into json {"x":[..],"y":[..]}
+func (self *EthCall) DecodeLandOf(result string) (string, error) {
+	return decodeJSON("(int256[] x,int256[] y)", result)
+}
+
+// DecodeOwnerOfLand decode OwnerOfLand result
+func (self *EthCall) DecodeOwnerOfLand(result string) (string, error) {
+	return decodeSingle("address", result)
+}
+
+// DecodeDescription decode Description result
+func (self *EthCall) DecodeDescription(result string) (string, error) {
+	return decodeText(result)
+}
+
+// DecodeTokensOf decode TokensOf result into json array of decimal token ids
+func (self *EthCall) DecodeTokensOf(result string) (string, error) {
+	return decodeJSON("uint256[]", result)
+}
+
+// DecodeExists decode Exists result
+func (self *EthCall) DecodeExists(result string) (bool, error) {
+	value, err := decodeValue("bool", result)
+
+	if err != nil {
+		return false, err
+	}
+
+	return value.(bool), nil
+}
+
+// DecodeTokenMetadata decode TokenMetadata result
+func (self *EthCall) DecodeTokenMetadata(result string) (string, error) {
+	return decodeText(result)
+}
+
+// DecodeTokenOfOwnerByIndex decode TokenOfOwnerByIndex result as decimal string
+func (self *EthCall) DecodeTokenOfOwnerByIndex(result string) (string, error) {
+	return decodeSingle("uint256", result)
+}
+
+// DecodeOwnerOf decode OwnerOf result
+func (self *EthCall) DecodeOwnerOf(result string) (string, error) {
+	return decodeSingle("address", result)
+}
+
+// DecodeRedPacketMaxCount decode RedPacketMaxCount result as decimal string, maxCount
+// is the uint256 set by changeMaxCount(uint256).
+//
+// RedPacketTaxCost, RedPacketOpenDetail and RedPacketStatus have no decoder yet:
+// their output types are not in this tree and the deployed contract's verified abi
+// is needed before they can be added. Until then they must be decoded by ABI.Decode
+// with that abi
+func (self *EthCall) DecodeRedPacketMaxCount(result string) (string, error) {
+	return decodeSingle("uint256", result)
+}
+
+// decodeValue decode result of single output type
+func decodeValue(typ string, result string) (interface{}, error) {
+	outputs, err := abi.ParseArguments(typ)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readHex(result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := outputs.Unpack(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0], nil
+}
+
+func decodeSingle(typ string, result string) (string, error) {
+	value, err := decodeValue(typ, result)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprint(value), nil
+}
+
+// decodeJSON decode result into json, single output is encoded as is and multiple
+// named outputs as object
+func decodeJSON(types string, result string) (string, error) {
+	outputs, err := abi.ParseArguments(types)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := readHex(result)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(outputs) > 1 {
+		return outputs.UnpackJSON(data)
+	}
+
+	values, err := outputs.Unpack(data)
+
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := json.Marshal(values[0])
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// decodeText decode string result, a single word result is bytes32 text padded with zeros
+func decodeText(result string) (string, error) {
+	data, err := readHex(result)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) == 32 {
+		return string(bytes.TrimRight(data, "\x00")), nil
+	}
+
+	return decodeSingle("string", result)
+}
@@ -0,0 +1,195 @@
+package ethmobile
+
+import (
+	"encoding/json"
+
+	"github.com/inwecrypto/mobilesdk/abi"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// known event abi, erc721 Transfer and Approval share topic0 with erc20 and are told
+// apart by indexed token id.
+//
+// Red packet contract events are not known events yet: their signatures are not in
+// this tree and need the deployed contract's verified abi. Until then red packet logs
+// are skipped by DecodeReceiptLogs and must be decoded by ABI.DecodeLog with that abi
+const (
+	erc20EventsABI = `[
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256"}]},
+		{"type":"event","name":"Approval","inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256"}]}
+	]`
+
+	erc721EventsABI = `[
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},
+		{"type":"event","name":"Approval","inputs":[{"name":"owner","type":"address","indexed":true},{"name":"approved","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},
+		{"type":"event","name":"ApprovalForAll","inputs":[{"name":"owner","type":"address","indexed":true},{"name":"operator","type":"address","indexed":true},{"name":"approved","type":"bool"}]}
+	]`
+)
+
+type logDecoder struct {
+	standard string
+	abi      *abi.ABI
+}
+
+var knownLogDecoders = []logDecoder{
+	{"erc20", mustParseABI(erc20EventsABI)},
+	{"erc721", mustParseABI(erc721EventsABI)},
+}
+
+func mustParseABI(data string) *abi.ABI {
+	contract, err := abi.Parse([]byte(data))
+
+	if err != nil {
+		panic(err)
+	}
+
+	return contract
+}
+
+// eventLog decoded log json
+type eventLog struct {
+	Address  string `json:"address,omitempty"`
+	Standard string `json:"standard,omitempty"` // erc20 or erc721, empty for custom abi
+	*abi.Log
+}
+
+// receiptLog log entry of eth_getTransactionReceipt result
+type receiptLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+// DecodeLog decode erc20 or erc721 event log, topics is json array of hex topics,
+// returns json {"address":"..","standard":"erc20","event":"Transfer","signature":"..","args":{..}}
+func DecodeLog(address, topics, data string) (string, error) {
+	return decodeLogJSON(knownLogDecoders, address, topics, data)
+}
+
+// DecodeReceiptLogs decode erc20 and erc721 logs of eth_getTransactionReceipt
+// result into json array, unknown logs and logs failing to decode are skipped
+func DecodeReceiptLogs(receipt string) (string, error) {
+	return decodeReceiptLogs(knownLogDecoders, receipt)
+}
+
+// DecodeLog decode event log with contract abi, falls back to known events
+func (contract *ABI) DecodeLog(address, topics, data string) (string, error) {
+	return decodeLogJSON(contract.logDecoders(), address, topics, data)
+}
+
+// DecodeReceiptLogs decode logs of eth_getTransactionReceipt result with contract abi
+// and known events, unknown logs and logs failing to decode are skipped
+func (contract *ABI) DecodeReceiptLogs(receipt string) (string, error) {
+	return decodeReceiptLogs(contract.logDecoders(), receipt)
+}
+
+func (contract *ABI) logDecoders() []logDecoder {
+	return append([]logDecoder{{"", contract.abi}}, knownLogDecoders...)
+}
+
+func decodeLogJSON(decoders []logDecoder, address, topics, data string) (string, error) {
+	var topicList []string
+
+	if err := json.Unmarshal([]byte(topics), &topicList); err != nil {
+		return "", err
+	}
+
+	log, err := decodeLog(decoders, &receiptLog{
+		Address: address,
+		Topics:  topicList,
+		Data:    data,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.Marshal(log)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+func decodeReceiptLogs(decoders []logDecoder, receipt string) (string, error) {
+	var parsed struct {
+		Logs []*receiptLog `json:"logs"`
+	}
+
+	if err := json.Unmarshal([]byte(receipt), &parsed); err != nil {
+		return "", err
+	}
+
+	logs := make([]*eventLog, 0, len(parsed.Logs))
+
+	for _, entry := range parsed.Logs {
+		// one non-standard log, such as a Transfer with empty data, must not hide
+		// the rest of the receipt
+		log, err := decodeLog(decoders, entry)
+
+		if err != nil {
+			continue
+		}
+
+		logs = append(logs, log)
+	}
+
+	result, err := json.Marshal(logs)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+func decodeLog(decoders []logDecoder, entry *receiptLog) (*eventLog, error) {
+	topics := make([][]byte, 0, len(entry.Topics))
+
+	for _, topic := range entry.Topics {
+		data, err := readHex(topic)
+
+		if err != nil {
+			return nil, err
+		}
+
+		topics = append(topics, data)
+	}
+
+	data, err := readHex(entry.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	address := entry.Address
+
+	if parsed, err := ethtx.ParseAddress(address); err == nil && parsed != nil {
+		address = ethtx.ChecksumAddress(parsed[:])
+	}
+
+	var lastErr error = abi.ErrEvent
+
+	for _, decoder := range decoders {
+		log, err := decoder.abi.DecodeLog(topics, data)
+
+		if err == abi.ErrEvent {
+			continue
+		}
+
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		return &eventLog{
+			Address:  address,
+			Standard: decoder.standard,
+			Log:      log,
+		}, nil
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,68 @@
+package ethmobile
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// SignPersonalMessage eip-191 personal_sign of utf-8 message, returns 0x prefixed
+// 65 bytes signature r || s || v
+func (wallet *Wallet) SignPersonalMessage(message string) (string, error) {
+	return wallet.SignPersonalMessageBytes([]byte(message))
+}
+
+// SignPersonalMessageBytes eip-191 personal_sign of binary message, such as hex
+// data passed by dapps to personal_sign
+func (wallet *Wallet) SignPersonalMessageBytes(message []byte) (string, error) {
+	return wallet.signHash(ethtx.PersonalMessageHash(message))
+}
+
+// EthSign sign 32 bytes hex hash as is without message prefix, only for dapps requiring raw eth_sign
+func (wallet *Wallet) EthSign(hash string) (string, error) {
+	data, err := readHex(hash)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) != 32 {
+		return "", fmt.Errorf("hash must be 32 bytes")
+	}
+
+	return wallet.signHash(data)
+}
+
+func (wallet *Wallet) signHash(hash []byte) (string, error) {
+	signature, err := ethtx.SignHash(hash, wallet.key.PrivateKey)
+
+	if err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(signature), nil
+}
+
+// RecoverPersonalMessage recover signer address of personal_sign signature
+func RecoverPersonalMessage(message string, signature string) (string, error) {
+	data, err := readHex(signature)
+
+	if err != nil {
+		return "", err
+	}
+
+	return ethtx.RecoverSigner(ethtx.PersonalMessageHash([]byte(message)), data)
+}
+
+// VerifyPersonalMessage check if personal_sign signature of message is signed by address
+func VerifyPersonalMessage(address string, message string, signature string) (bool, error) {
+	signer, err := RecoverPersonalMessage(message, signature)
+
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(signer, address), nil
+}
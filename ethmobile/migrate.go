@@ -0,0 +1,120 @@
+package ethmobile
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/inwecrypto/ethgo"
+)
+
+// BalanceChecker on chain activity checker implemented by host app
+type BalanceChecker interface {
+	// HasActivity return true if address holds any balance or has sent any transaction
+	HasActivity(address string) (bool, error)
+}
+
+// MnemonicImport wallet candidates restored from one mnemonic
+type MnemonicImport struct {
+	legacy         *Wallet
+	standard       *Wallet
+	LegacyActive   bool // legacy address has on chain activity
+	StandardActive bool // bip44 address has on chain activity
+}
+
+// ImportMnemonic restore both legacy and bip44 (m/44'/60'/0'/0/0) wallets from mnemonic,
+// and check on chain activity of both addresses if checker is not nil
+func ImportMnemonic(mnemonic string, lang string, checker BalanceChecker) (*MnemonicImport, error) {
+	standard, err := FromMnemonicAccount(mnemonic, lang, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MnemonicImport{
+		standard: standard,
+	}
+
+	// phrases rejected by the legacy decoder never restored a legacy wallet
+	if legacy, err := FromLegacyMnemonic(mnemonic, lang); err == nil {
+		result.legacy = legacy
+	}
+
+	if checker == nil {
+		return result, nil
+	}
+
+	if result.StandardActive, err = checker.HasActivity(standard.Address()); err != nil {
+		return nil, err
+	}
+
+	if result.legacy != nil {
+		if result.LegacyActive, err = checker.HasActivity(result.legacy.Address()); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Legacy get legacy wallet candidate, return nil if mnemonic can't be a legacy one
+func (imp *MnemonicImport) Legacy() *Wallet {
+	return imp.legacy
+}
+
+// Standard get bip44 wallet candidate
+func (imp *MnemonicImport) Standard() *Wallet {
+	return imp.standard
+}
+
+// HasLegacy check if mnemonic restores a legacy wallet
+func (imp *MnemonicImport) HasLegacy() bool {
+	return imp.legacy != nil
+}
+
+// NeedMigration check if funds should be moved from legacy address to bip44 address
+func (imp *MnemonicImport) NeedMigration() bool {
+	return imp.legacy != nil && imp.LegacyActive
+}
+
+// MigrateTx create tx moving the whole eth balance from legacy address to bip44 address,
+// the fee gasPrice * gasLimits is deducted from balance
+func (imp *MnemonicImport) MigrateTx(chainID, nonce, balance, gasPrice, gasLimits string) (*SignedTx, error) {
+	if imp.legacy == nil {
+		return nil, fmt.Errorf("mnemonic has no legacy wallet")
+	}
+
+	balanceBigInt, err := readBigint(balance)
+
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceBigInt, err := readBigint(gasPrice)
+
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimitsBigInt, err := readBigint(gasLimits)
+
+	if err != nil {
+		return nil, err
+	}
+
+	amount := new(big.Int).Sub(balanceBigInt, new(big.Int).Mul(gasPriceBigInt, gasLimitsBigInt))
+
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("balance %s can't cover the tx fee", balanceBigInt)
+	}
+
+	return imp.legacy.createTxData(chainID, imp.standard.Address(), nonce, gasPrice, gasLimits, (*ethgo.Value)(amount), nil)
+}
+
+// MigrateERC20Tx create tx moving erc20 token amount from legacy address to bip44 address
+func (imp *MnemonicImport) MigrateERC20Tx(chainID, contract, nonce, amount, gasPrice, gasLimits string) (*SignedTx, error) {
+	if imp.legacy == nil {
+		return nil, fmt.Errorf("mnemonic has no legacy wallet")
+	}
+
+	return imp.legacy.TransferERC20(chainID, contract, nonce, imp.standard.Address(), amount, gasPrice, gasLimits)
+}
@@ -2,6 +2,7 @@ package ethmobile
 
 import (
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"strings"
 
@@ -10,25 +11,45 @@ import (
 	"github.com/inwecrypto/ethgo/erc20"
 	"github.com/inwecrypto/ethgo/erc721"
 	"github.com/inwecrypto/ethgo/keystore"
-	"github.com/inwecrypto/ethgo/tx"
+	"github.com/inwecrypto/gosecp256k1"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/inwecrypto/mobilesdk/hdkey"
+	"github.com/inwecrypto/mobilesdk/seedphrase"
+	"github.com/inwecrypto/mobilesdk/web3keystore"
 )
 
+// ETHPath bip44 derivation path of eth account, %d is the account index
+const ETHPath = "m/44'/60'/0'/0/%d"
+
 // Wallet neo mobile wallet
 type Wallet struct {
-	key *keystore.Key
+	key        *keystore.Key
+	passphrase bool   // key is derived from bip39 seed protected by passphrase
+	mnemonic   string // normalized mnemonic, empty if wallet is not created from mnemonic
+	lang       string // mnemonic language
 }
 
-// New create a new wallet
+// New create a new wallet from a random 12 words mnemonic, the mnemonic can be
+// exported with Wallet.Mnemonic
 func New() (*Wallet, error) {
-	key, err := keystore.NewKey()
+	mnemonic, err := seedphrase.Generate(12, "en_US", nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &Wallet{
-		key: key,
-	}, nil
+	return FromMnemonicAccount(mnemonic, "en_US", 0)
+}
+
+// GenerateMnemonic create random mnemonic with words count 12, 15, 18, 21 or 24
+func GenerateMnemonic(words int, lang string) (string, error) {
+	return seedphrase.Generate(words, lang, nil)
+}
+
+// GenerateMnemonicWithEntropy create random mnemonic with extra entropy supplied by
+// host app such as dice rolls, which is mixed with system random bytes
+func GenerateMnemonicWithEntropy(words int, lang string, extraEntropy []byte) (string, error) {
+	return seedphrase.Generate(words, lang, extraEntropy)
 }
 
 // FromPrivateKey .
@@ -55,9 +76,26 @@ func FromPrivateKey(privateKey string) (*Wallet, error) {
 	}, nil
 }
 
-// FromMnemonic create wallet from mnemonic
+// DetectMnemonicLanguage detect mnemonic language, result can be used as lang parameter
+func DetectMnemonicLanguage(mnemonic string) (string, error) {
+	return seedphrase.DetectLanguage(mnemonic)
+}
+
+// FromMnemonic create wallet of the first account derived from mnemonic, empty lang
+// means detect language automatically. Mnemonic exported by old versions must be
+// imported with FromLegacyMnemonic or ImportMnemonic
 func FromMnemonic(mnemonic string, lang string) (*Wallet, error) {
-	dic, _ := bip39.GetDict(lang)
+	return FromMnemonicAccount(mnemonic, lang, 0)
+}
+
+// FromLegacyMnemonic create wallet from mnemonic generated by Wallet.LegacyMnemonic,
+// which encodes the private key itself as bip39 entropy
+func FromLegacyMnemonic(mnemonic string, lang string) (*Wallet, error) {
+	_, dic, err := seedphrase.ResolveDict(mnemonic, lang)
+
+	if err != nil {
+		return nil, err
+	}
 
 	data, err := bip39.MnemonicToByteArray(mnemonic, dic)
 
@@ -67,8 +105,6 @@ func FromMnemonic(mnemonic string, lang string) (*Wallet, error) {
 
 	data = data[1 : len(data)-1]
 
-	println(hex.EncodeToString(data))
-
 	key, err := keystore.KeyFromPrivateKey(data)
 
 	if err != nil {
@@ -80,6 +116,75 @@ func FromMnemonic(mnemonic string, lang string) (*Wallet, error) {
 	}, nil
 }
 
+// FromMnemonicAccount create wallet from mnemonic with bip44 path m/44'/60'/0'/0/index
+func FromMnemonicAccount(mnemonic string, lang string, index int) (*Wallet, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("invalid account index %d", index)
+	}
+
+	return FromMnemonicPath(mnemonic, lang, fmt.Sprintf(ETHPath, index))
+}
+
+// FromMnemonicPath create wallet from mnemonic with custom bip32 derivation path
+func FromMnemonicPath(mnemonic string, lang string, path string) (*Wallet, error) {
+	return FromMnemonicPathWithPassphrase(mnemonic, lang, "", path)
+}
+
+// FromMnemonicWithPassphrase create wallet from mnemonic and bip39 passphrase with account index
+func FromMnemonicWithPassphrase(mnemonic string, lang string, passphrase string, index int) (*Wallet, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("invalid account index %d", index)
+	}
+
+	return FromMnemonicPathWithPassphrase(mnemonic, lang, passphrase, fmt.Sprintf(ETHPath, index))
+}
+
+// FromMnemonicPathWithPassphrase create wallet from mnemonic and bip39 passphrase with custom bip32 derivation path
+func FromMnemonicPathWithPassphrase(mnemonic string, lang string, passphrase string, path string) (*Wallet, error) {
+	lang, dic, err := seedphrase.ResolveDict(mnemonic, lang)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := seedphrase.NewSeed(mnemonic, passphrase, dic)
+
+	if err != nil {
+		return nil, err
+	}
+
+	master, err := hdkey.NewMasterKey(seed, secp256k1.S256())
+
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := master.DerivePath(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FromHDKey(child, mnemonic, lang, passphrase != "")
+}
+
+// FromHDKey create wallet of key derived from mnemonic, keeping the mnemonic context
+// so that Mnemonic and keystore metadata are the same as FromMnemonicPathWithPassphrase
+func FromHDKey(child *hdkey.Key, mnemonic string, lang string, passphrase bool) (*Wallet, error) {
+	key, err := keystore.KeyFromPrivateKey(child.PrivateKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Wallet{
+		key:        key,
+		passphrase: passphrase,
+		mnemonic:   seedphrase.Normalize(mnemonic),
+		lang:       lang,
+	}, nil
+}
+
 // FromKeyStore create wallet from keystore
 func FromKeyStore(ks string, password string) (*Wallet, error) {
 	key, err := keystore.ReadKeyStore([]byte(ks), password)
@@ -88,8 +193,15 @@ func FromKeyStore(ks string, password string) (*Wallet, error) {
 		return nil, err
 	}
 
+	meta, err := web3keystore.ReadMeta([]byte(ks))
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &Wallet{
-		key: key,
+		key:        key,
+		passphrase: meta.Passphrase,
 	}, nil
 }
 
@@ -98,137 +210,199 @@ func (wallet *Wallet) Address() string {
 	return wallet.key.Address
 }
 
-// Mnemonic gete mnemonic string
+// Mnemonic get the mnemonic wallet is created from, lang must be empty or the
+// mnemonic's language since bip39 seed depends on the words. Wallets not created
+// from mnemonic have no phrase and return an error, see HasMnemonic
 func (wallet *Wallet) Mnemonic(lang string) (string, error) {
-	privateKeyBytes := wallet.key.ToBytes()
+	if wallet.mnemonic == "" {
+		return "", fmt.Errorf("wallet is not created from mnemonic")
+	}
 
-	dic, _ := bip39.GetDict(lang)
+	if lang != "" && lang != wallet.lang {
+		return "", fmt.Errorf("wallet mnemonic language is %s", wallet.lang)
+	}
 
-	println(hex.EncodeToString(privateKeyBytes))
+	return wallet.mnemonic, nil
+}
 
-	data, err := bip39.NewMnemonic(privateKeyBytes, dic)
+// LegacyMnemonic encode private key itself as bip39 entropy, the phrase is not
+// a bip39 seed phrase and can only be imported with FromLegacyMnemonic
+func (wallet *Wallet) LegacyMnemonic(lang string) (string, error) {
+	dic, err := seedphrase.Dict(lang)
 
 	if err != nil {
 		return "", err
 	}
 
-	return data, nil
+	return bip39.NewMnemonic(wallet.key.ToBytes(), dic)
 }
 
-// ToKeyStore write wallet to keystore format string
+// ToKeyStore write wallet to light scrypt keystore format string
 func (wallet *Wallet) ToKeyStore(password string) (string, error) {
-	keystore, err := keystore.WriteLightScryptKeyStore(wallet.key, password)
+	return wallet.ToKeyStoreWithOptions(password, nil)
+}
 
-	return string(keystore), err
+// HasMnemonic check if wallet is created from mnemonic
+func (wallet *Wallet) HasMnemonic() bool {
+	return wallet.mnemonic != ""
+}
+
+// MnemonicLang get language of the mnemonic wallet is created from
+func (wallet *Wallet) MnemonicLang() string {
+	return wallet.lang
+}
+
+// UsesPassphrase check if wallet is derived from bip39 seed protected by passphrase
+func (wallet *Wallet) UsesPassphrase() bool {
+	return wallet.passphrase
 }
 
 // Transfer transfer eth to target address
-func (wallet *Wallet) Transfer(nonce, to, amount, gasPrice, gasLimits string) (string, error) {
+func (wallet *Wallet) Transfer(chainID, nonce, to, amount, gasPrice, gasLimits string) (*SignedTx, error) {
 
 	amountBigInt, err := readBigint(amount)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return wallet.createTxData(to, nonce, gasPrice, gasLimits, (*ethgo.Value)(amountBigInt), nil)
+	return wallet.createTxData(chainID, to, nonce, gasPrice, gasLimits, (*ethgo.Value)(amountBigInt), nil)
 }
 
 // TransferERC20 transfer eth to target address
-func (wallet *Wallet) TransferERC20(contract, nonce, to, amount, gasPrice, gasLimits string) (string, error) {
+func (wallet *Wallet) TransferERC20(chainID, contract, nonce, to, amount, gasPrice, gasLimits string) (*SignedTx, error) {
 
 	codes, err := erc20.Transfer(to, amount)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return wallet.createTxData(contract, nonce, gasPrice, gasLimits, nil, codes)
+	return wallet.createTxData(chainID, contract, nonce, gasPrice, gasLimits, nil, codes)
 }
 
-func (wallet *Wallet) Approve(contract, nonce, to, value, gasPrice, gasLimits string) (string, error) {
+func (wallet *Wallet) Approve(chainID, contract, nonce, to, value, gasPrice, gasLimits string) (*SignedTx, error) {
 
 	codes, err := erc20.Approve(to, value)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return wallet.createTxData(contract, nonce, gasPrice, gasLimits, nil, codes)
+	return wallet.createTxData(chainID, contract, nonce, gasPrice, gasLimits, nil, codes)
 }
 
-func (wallet *Wallet) TransferFrom(contract, nonce, from, to, value, gasPrice, gasLimits string) (string, error) {
+func (wallet *Wallet) TransferFrom(chainID, contract, nonce, from, to, value, gasPrice, gasLimits string) (*SignedTx, error) {
 
 	codes, err := erc20.TransferFrom(from, to, value)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return wallet.createTxData(contract, nonce, gasPrice, gasLimits, nil, codes)
+	return wallet.createTxData(chainID, contract, nonce, gasPrice, gasLimits, nil, codes)
 }
 
-func (wallet *Wallet) TransferLand(contract, nonce, to, x, y, gasPrice, gasLimits string) (string, error) {
+func (wallet *Wallet) TransferLand(chainID, contract, nonce, to, x, y, gasPrice, gasLimits string) (*SignedTx, error) {
 
 	codes, err := erc721.TransferLand(to, x, y)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return wallet.createTxData(contract, nonce, gasPrice, gasLimits, nil, codes)
+	return wallet.createTxData(chainID, contract, nonce, gasPrice, gasLimits, nil, codes)
 }
 
-func (wallet *Wallet) NewRedPacket(redcontract, nonce, erc20contract, tokenId, from, amount, value, count, command, gasPrice, gasLimits string) (string, error) {
+func (wallet *Wallet) NewRedPacket(chainID, redcontract, nonce, erc20contract, tokenId, from, amount, value, count, command, gasPrice, gasLimits string) (*SignedTx, error) {
 	amountBigInt, err := readBigint(amount)
 
+	if err != nil {
+		return nil, err
+	}
+
 	codes, err := erc721.NewRedPacket(tokenId, erc20contract, from, value, count, command)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return wallet.createTxData(redcontract, nonce, gasPrice, gasLimits, (*ethgo.Value)(amountBigInt), codes)
+	return wallet.createTxData(chainID, redcontract, nonce, gasPrice, gasLimits, (*ethgo.Value)(amountBigInt), codes)
 }
 
-func (wallet *Wallet) createTxData(to, nonce, gasPrice, gasLimits string, amount *ethgo.Value, codes []byte) (string, error) {
+// createTxData create eip-155 signed legacy tx to recipient, returns signed tx with metadata
+func (wallet *Wallet) createTxData(chainID, to, nonce, gasPrice, gasLimits string, amount *ethgo.Value, codes []byte) (*SignedTx, error) {
+	recipient, err := readRecipient(to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.signLegacyTx(chainID, recipient, nonce, gasPrice, gasLimits, amount, codes)
+}
+
+// signLegacyTx create eip-155 signed legacy tx, nil recipient means contract creation
+func (wallet *Wallet) signLegacyTx(chainID string, recipient *[20]byte, nonce, gasPrice, gasLimits string, amount *ethgo.Value, codes []byte) (*SignedTx, error) {
+	chainIDBigInt, err := readBigint(chainID)
+
+	if err != nil {
+		return nil, err
+	}
+
 	nonceBigInt, err := readBigint(nonce)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	gasPriceBigInt, err := readBigint(gasPrice)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	gasLimitsBigInt, err := readBigint(gasLimits)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	rawTx := tx.NewTx(
+	rawTx := ethtx.NewLegacyTx(
+		chainIDBigInt,
 		nonceBigInt.Uint64(),
-		to,
-		amount,
-		(*ethgo.Value)(gasPriceBigInt),
+		recipient,
+		(*big.Int)(amount),
+		gasPriceBigInt,
 		gasLimitsBigInt,
 		codes)
 
 	if err := rawTx.Sign(wallet.key.PrivateKey); err != nil {
-		return "", err
+		return nil, err
 	}
 
 	data, err := rawTx.Encode()
 
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return wallet.newSignedTx(data, chainIDBigInt, nonceBigInt.Uint64(), gasLimitsBigInt, gasPriceBigInt, recipient)
+}
+
+// readRecipient parse recipient address, empty address is rejected since it
+// would turn the tx into a contract creation
+func readRecipient(to string) (*[20]byte, error) {
+	recipient, err := ethtx.ParseAddress(to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if recipient == nil {
+		return nil, fmt.Errorf("recipient address is required")
 	}
 
-	return hex.EncodeToString(data), nil
+	return recipient, nil
 }
 
 func readBigint(source string) (*big.Int, error) {
@@ -0,0 +1,17 @@
+package ethmobile
+
+import (
+	web3 "github.com/inwecrypto/keystore"
+	"github.com/inwecrypto/mobilesdk/keystoremobile"
+)
+
+// ToKeyStoreWithOptions write wallet to keystore format string with kdf options
+func (wallet *Wallet) ToKeyStoreWithOptions(password string, options *keystoremobile.KeyStoreOptions) (string, error) {
+	key := &web3.Key{
+		ID:         wallet.key.ID,
+		Address:    wallet.key.Address,
+		PrivateKey: wallet.key.ToBytes(),
+	}
+
+	return keystoremobile.Encrypt(key, password, options, wallet.passphrase)
+}
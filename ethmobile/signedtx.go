@@ -0,0 +1,50 @@
+package ethmobile
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// SignedTx signed eth transaction, numbers are hex encoded like the builder parameters
+type SignedTx struct {
+	Data            string // hex encoded raw tx
+	Hash            string // tx hash, 0x prefixed
+	From            string
+	Nonce           string
+	MaxFee          string // gasLimits * gasPrice, maxFeePerGas for eip-1559 tx
+	Size            int    // raw tx size in bytes
+	ChainID         string
+	ContractAddress string // address of created contract, empty if tx is not contract creation
+}
+
+func (wallet *Wallet) newSignedTx(data []byte, chainID *big.Int, nonce uint64, gasLimit, gasPrice *big.Int, to *[20]byte) (*SignedTx, error) {
+	signedTx := &SignedTx{
+		Data:    hex.EncodeToString(data),
+		Hash:    "0x" + hex.EncodeToString(ethtx.Keccak256(data)),
+		From:    wallet.key.Address,
+		Nonce:   "0x" + new(big.Int).SetUint64(nonce).Text(16),
+		MaxFee:  "0x" + new(big.Int).Mul(gasLimit, gasPrice).Text(16),
+		Size:    len(data),
+		ChainID: "0x" + chainID.Text(16),
+	}
+
+	if to == nil {
+		sender, err := ethtx.ParseAddress(wallet.key.Address)
+
+		if err != nil {
+			return nil, err
+		}
+
+		address, err := ethtx.CreateAddress(*sender, nonce)
+
+		if err != nil {
+			return nil, err
+		}
+
+		signedTx.ContractAddress = ethtx.ChecksumAddress(address[:])
+	}
+
+	return signedTx, nil
+}
@@ -0,0 +1,84 @@
+package ethmobile
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/inwecrypto/mobilesdk/siwe"
+)
+
+// BuildSiweMessage build eip-4361 sign-in with ethereum message text from fields json:
+// {"domain","address","statement","uri","version","chainId","nonce","issuedAt",
+// "expirationTime","notBefore","requestId","resources"}
+func BuildSiweMessage(fields string) (string, error) {
+	var msg *siwe.Message
+
+	if err := json.Unmarshal([]byte(fields), &msg); err != nil {
+		return "", err
+	}
+
+	if msg == nil {
+		return "", fmt.Errorf("siwe message fields are required")
+	}
+
+	if err := msg.Validate(); err != nil {
+		return "", err
+	}
+
+	return msg.String(), nil
+}
+
+// ParseSiweMessage strictly parse eip-4361 message text, returns fields json
+func ParseSiweMessage(message string) (string, error) {
+	msg, err := siwe.Parse(message)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(msg)
+
+	return string(data), err
+}
+
+// GenerateSiweNonce random alphanumeric nonce for siwe message
+func GenerateSiweNonce() (string, error) {
+	return siwe.GenerateNonce()
+}
+
+// SignSiweMessage personal_sign eip-4361 message text, the message must be valid
+// and its address must be the wallet address
+func (wallet *Wallet) SignSiweMessage(message string) (string, error) {
+	msg, err := siwe.Parse(message)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.EqualFold(msg.Address, wallet.Address()) {
+		return "", fmt.Errorf("siwe message address %s is not wallet address", msg.Address)
+	}
+
+	return wallet.signHash(msg.Hash())
+}
+
+// VerifySiweMessage verify signature of eip-4361 message text at current time,
+// returns nil if valid. domain is the expected origin and is required, empty nonce
+// skips the nonce check
+func VerifySiweMessage(message, signature, domain, nonce string) error {
+	msg, err := siwe.Parse(message)
+
+	if err != nil {
+		return err
+	}
+
+	data, err := readHex(signature)
+
+	if err != nil {
+		return err
+	}
+
+	return msg.Verify(data, domain, nonce, time.Now())
+}
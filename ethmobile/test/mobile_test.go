@@ -0,0 +1,750 @@
+package ethmobiletest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/ethmobile"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/inwecrypto/mobilesdk/keystoremobile"
+	"github.com/inwecrypto/mobilesdk/siwe"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestFromMnemonicAccount(t *testing.T) {
+	wallet, err := ethmobile.FromMnemonicAccount(testMnemonic, "en_US", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", wallet.Address())
+
+	wallet2, err := ethmobile.FromMnemonicPath(testMnemonic, "en_US", "m/44'/60'/0'/0/0")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), wallet2.Address())
+
+	wallet3, err := ethmobile.FromMnemonicAccount(testMnemonic, "en_US", 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0", wallet3.Address())
+}
+
+func TestFromMnemonicInvalid(t *testing.T) {
+	_, err := ethmobile.FromMnemonicAccount("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "en_US", 0)
+
+	assert.Error(t, err)
+
+	_, err = ethmobile.FromMnemonicAccount(testMnemonic, "xx_XX", 0)
+
+	assert.Error(t, err)
+}
+
+type activityChecker map[string]bool
+
+func (checker activityChecker) HasActivity(address string) (bool, error) {
+	return checker[address], nil
+}
+
+func TestImportLegacyMnemonic(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+	assert.False(t, wallet.HasMnemonic())
+
+	_, err = wallet.Mnemonic("en_US")
+
+	assert.Error(t, err)
+
+	mnemonic, err := wallet.LegacyMnemonic("en_US")
+
+	assert.NoError(t, err)
+
+	imported, err := ethmobile.ImportMnemonic(mnemonic, "en_US", activityChecker{wallet.Address(): true})
+
+	if !assert.NoError(t, err) || !assert.True(t, imported.HasLegacy()) {
+		return
+	}
+
+	assert.Equal(t, wallet.Address(), imported.Legacy().Address())
+	assert.NotEqual(t, wallet.Address(), imported.Standard().Address())
+	assert.True(t, imported.LegacyActive)
+	assert.False(t, imported.StandardActive)
+	assert.True(t, imported.NeedMigration())
+
+	_, err = imported.MigrateTx("0x1", "0x1", "0x5208", "0x1", "0x5208")
+
+	assert.Error(t, err)
+
+	rawtx, err := imported.MigrateTx("0x1", "0x1", "0xde0b6b3a7640000", "0x4a817c800", "0x5208")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rawtx.Data)
+}
+
+func TestImportStandardMnemonic(t *testing.T) {
+	imported, err := ethmobile.ImportMnemonic(testMnemonic, "en_US", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", imported.Standard().Address())
+	assert.False(t, imported.NeedMigration())
+}
+
+func TestFromMnemonicWithPassphrase(t *testing.T) {
+	wallet, err := ethmobile.FromMnemonicWithPassphrase(testMnemonic, "en_US", "", 0)
+
+	assert.NoError(t, err)
+	assert.False(t, wallet.UsesPassphrase())
+	assert.Equal(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", wallet.Address())
+
+	hidden, err := ethmobile.FromMnemonicWithPassphrase(testMnemonic, "en_US", "TREZOR", 0)
+
+	assert.NoError(t, err)
+	assert.True(t, hidden.UsesPassphrase())
+	assert.NotEqual(t, wallet.Address(), hidden.Address())
+
+	ks, err := hidden.ToKeyStore("test")
+
+	assert.NoError(t, err)
+
+	restored, err := ethmobile.FromKeyStore(ks, "test")
+
+	assert.NoError(t, err)
+	assert.Equal(t, hidden.Address(), restored.Address())
+	assert.True(t, restored.UsesPassphrase())
+
+	ks, err = wallet.ToKeyStore("test")
+
+	assert.NoError(t, err)
+
+	restored, err = ethmobile.FromKeyStore(ks, "test")
+
+	assert.NoError(t, err)
+	assert.False(t, restored.UsesPassphrase())
+}
+
+func TestKeyStoreOptions(t *testing.T) {
+	wallet, err := ethmobile.FromMnemonicWithPassphrase(testMnemonic, "en_US", "secret", 0)
+
+	assert.NoError(t, err)
+
+	ks, err := wallet.ToKeyStoreWithOptions("password", keystoremobile.NewPBKDF2KeyStoreOptions(1000))
+
+	assert.NoError(t, err)
+	assert.Contains(t, ks, `"kdf":"pbkdf2"`)
+
+	restored, err := ethmobile.FromKeyStore(ks, "password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), restored.Address())
+	assert.True(t, restored.UsesPassphrase())
+
+	options := keystoremobile.NewKeyStoreOptions()
+	options.N = 1 << 10
+
+	ks, err = wallet.ToKeyStoreWithOptions("password", options)
+
+	assert.NoError(t, err)
+
+	restored, err = ethmobile.FromKeyStore(ks, "password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), restored.Address())
+
+	options.N = 1000
+
+	_, err = wallet.ToKeyStoreWithOptions("password", options)
+
+	assert.Error(t, err)
+}
+
+func TestChangeKeyStorePassword(t *testing.T) {
+	wallet, err := ethmobile.FromMnemonicAccount(testMnemonic, "en_US", 0)
+
+	assert.NoError(t, err)
+
+	ks, err := wallet.ToKeyStore("old")
+
+	assert.NoError(t, err)
+
+	changed, err := keystoremobile.ChangeKeyStorePassword(ks, "old", "new", nil)
+
+	assert.NoError(t, err)
+
+	restored, err := ethmobile.FromKeyStore(changed, "new")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), restored.Address())
+
+	_, err = keystoremobile.ChangeKeyStorePassword(ks, "wrong", "new", nil)
+
+	assert.Error(t, err)
+}
+
+func TestDetectMnemonicLanguage(t *testing.T) {
+	lang, err := ethmobile.DetectMnemonicLanguage(testMnemonic)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "en_US", lang)
+
+	wallet, err := ethmobile.FromMnemonicAccount(testMnemonic, "", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", wallet.Address())
+
+	_, err = ethmobile.FromMnemonicAccount(testMnemonic, "xx_XX", 0)
+
+	assert.Error(t, err)
+}
+
+func TestTransferEIP155(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+
+	rawtx, err := wallet.Transfer("0x1", "0x9", "0x3535353535353535353535353535353535353535", "0xde0b6b3a7640000", "0x4a817c800", "0x5208")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", rawtx.Data)
+	assert.Equal(t, "0x33469b22e9f636356c4160a87eb19df52b7412e8eac32a4a55ffe88ea8350788", rawtx.Hash)
+	assert.Equal(t, wallet.Address(), rawtx.From)
+	assert.Equal(t, "0x9", rawtx.Nonce)
+	assert.Equal(t, "0x1", rawtx.ChainID)
+	assert.Equal(t, "0x17dfcdece4000", rawtx.MaxFee)
+	assert.Equal(t, 110, rawtx.Size)
+	assert.Empty(t, rawtx.ContractAddress)
+
+	_, err = wallet.Transfer("0x0", "0x9", "0x3535353535353535353535353535353535353535", "0xde0b6b3a7640000", "0x4a817c800", "0x5208")
+
+	assert.Error(t, err)
+
+	_, err = wallet.TransferERC20("0x1", "0x3535", "0x9", "0x3535353535353535353535353535353535353535", "0x1", "0x4a817c800", "0x5208")
+
+	assert.Error(t, err)
+}
+
+func TestTransferEIP1559(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+
+	rawtx, err := wallet.TransferEIP1559("0x1", "0x9", "0x3535353535353535353535353535353535353535", "0x3e8", "0x77359400", "0x6fc23ac00", "0x5208", "")
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rawtx.Data, "02f8"))
+	assert.Contains(t, rawtx.Data, "010984773594008506fc23ac008252089435353535353535353535353535353535353535358203e880c0")
+
+	rawtx, err = wallet.TransferERC20EIP1559("0x1", "0x3535353535353535353535353535353535353535", "0x9", "0x3535353535353535353535353535353535353535", "0x1", "0x77359400", "0x6fc23ac00", "0xea60", "")
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rawtx.Data, "02"))
+
+	_, err = wallet.TransferEIP1559("0x1", "0x9", "0x3535353535353535353535353535353535353535", "0x3e8", "0x6fc23ac00", "0x77359400", "0x5208", "")
+
+	assert.Error(t, err)
+}
+
+func TestTransferEIP2930(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+
+	rawtx, err := wallet.TransferEIP2930("0x1", "0x0", "0x3535353535353535353535353535353535353535", "0x3e8", "0x1", "0x5208", "")
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rawtx.Data, "01f8"))
+	assert.Contains(t, rawtx.Data, "0180018252089435353535353535353535353535353535353535358203e880c0")
+
+	accessList := `[{"address":"0x3535353535353535353535353535353535353535","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001"]}]`
+
+	rawtx, err = wallet.TransferERC20EIP2930("0x1", "0x3535353535353535353535353535353535353535", "0x0", "0x3535353535353535353535353535353535353535", "0x1", "0x1", "0xea60", accessList)
+
+	assert.NoError(t, err)
+	assert.Contains(t, rawtx.Data, "f838f7943535353535353535353535353535353535353535e1a00000000000000000000000000000000000000000000000000000000000000001")
+
+	rawtx, err = wallet.TransferEIP1559("0x1", "0x0", "0x3535353535353535353535353535353535353535", "0x3e8", "0x1", "0x1", "0x5208", accessList)
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rawtx.Data, "02f8"))
+	assert.Contains(t, rawtx.Data, "f838f7943535353535353535353535353535353535353535e1a00000000000000000000000000000000000000000000000000000000000000001")
+
+	_, err = wallet.TransferEIP2930("0x1", "0x0", "0x3535353535353535353535353535353535353535", "0x3e8", "0x1", "0x5208", "[{")
+
+	assert.Error(t, err)
+}
+
+func TestDeployContract(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+
+	deployment, err := wallet.DeployContract("0x1", "0x0", "0x6080604052", "", "0x0", "0x4a817c800", "0x30d40")
+
+	assert.NoError(t, err)
+
+	// nonce 0, gasPrice, gas, empty recipient, value 0, data
+	assert.Contains(t, deployment.Data, "808504a817c80083030d408080856080604052")
+
+	address, err := ethmobile.PredictContractAddress(wallet.Address(), "0x0")
+
+	assert.NoError(t, err)
+	assert.Equal(t, address, deployment.ContractAddress)
+
+	deployment, err = wallet.DeployContractEIP1559("0x1", "0x1", "0x6080604052", "0x01", "0x0", "0x1", "0x2", "0x30d40", "")
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(deployment.Data, "02f8"))
+	assert.Equal(t, "0x61a80", deployment.MaxFee)
+
+	address, err = ethmobile.PredictContractAddress(wallet.Address(), "0x1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, address, deployment.ContractAddress)
+
+	_, err = wallet.DeployContract("0x1", "0x0", "", "", "0x0", "0x4a817c800", "0x30d40")
+
+	assert.Error(t, err)
+
+	address, err = ethmobile.PredictContractAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", "0x1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x343c43a37d37dff08ae8c4a11544c718abb4fcf8", strings.ToLower(address))
+
+	address, err = ethmobile.PredictCreate2Address("0x00000000000000000000000000000000deadbeef", "0x00000000000000000000000000000000000000000000000000000000cafebabe", "0xdeadbeef")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7", address)
+}
+
+func TestDecodeTransaction(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+
+	tx, err := wallet.TransferERC20EIP1559("0x1", "0x3535353535353535353535353535353535353535", "0x9", "0x0000000000000000000000000000000000000001", "0x3e8", "0x1", "0x2", "0xea60", "")
+
+	assert.NoError(t, err)
+
+	result, err := ethmobile.DecodeTransaction(tx.Data)
+
+	assert.NoError(t, err)
+
+	var decoded map[string]interface{}
+
+	assert.NoError(t, json.Unmarshal([]byte(result), &decoded))
+	assert.Equal(t, "0x2", decoded["type"])
+	assert.Equal(t, "0x1", decoded["chainId"])
+	assert.Equal(t, "0x9", decoded["nonce"])
+	assert.Equal(t, "0x2", decoded["maxFeePerGas"])
+	assert.Equal(t, tx.Hash, decoded["hash"])
+	assert.Equal(t, wallet.Address(), decoded["from"])
+	assert.Nil(t, decoded["gasPrice"])
+
+	call := decoded["call"].(map[string]interface{})
+
+	assert.Equal(t, "transfer", call["method"])
+	assert.Equal(t, "1000", call["arguments"].([]interface{})[1].(map[string]interface{})["value"])
+
+	deployment, err := wallet.DeployContract("0x1", "0x2", "0x6080604052", "", "0x0", "0x1", "0x30d40")
+
+	assert.NoError(t, err)
+
+	result, err = ethmobile.DecodeTransaction("0x" + deployment.Data)
+
+	assert.NoError(t, err)
+	assert.Contains(t, result, `"contractAddress":"`+deployment.ContractAddress+`"`)
+	assert.NotContains(t, result, `"call"`)
+
+	_, err = ethmobile.DecodeTransaction("0x04c0")
+
+	assert.Error(t, err)
+}
+
+const erc20ABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"batch","inputs":[{"name":"to","type":"address[]"},{"name":"memo","type":"string"}],"outputs":[]},
+	{"type":"constructor","inputs":[{"name":"name","type":"string"}]}
+]`
+
+func TestABI(t *testing.T) {
+	contract, err := ethmobile.NewABI(erc20ABI)
+
+	assert.NoError(t, err)
+
+	data, err := contract.Encode("transfer", `["0x3535353535353535353535353535353535353535", "0x3e8"]`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0xa9059cbb000000000000000000000000353535353535353535353535353535353535353500000000000000000000000000000000000000000000000000000000000003e8", data)
+
+	call, err := ethmobile.EncodeCall("transfer(address,uint256)", `["0x3535353535353535353535353535353535353535", 1000]`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, data, call)
+
+	id, err := contract.MethodID("batch")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x"+hex.EncodeToString(ethtx.Keccak256([]byte("batch(address[],string)"))[:4]), id)
+
+	args, err := contract.EncodeConstructor(`["token"]`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2+3*64, len(args))
+
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+
+	tx, err := wallet.CallContract("0x1", "0x3535353535353535353535353535353535353535", "0x0", "0x0", data, "0x1", "0xea60")
+
+	assert.NoError(t, err)
+	assert.Contains(t, tx.Data, data[2:])
+
+	_, err = contract.Encode("transfer", `["0x3535"]`)
+
+	assert.Error(t, err)
+}
+
+func TestEthCallDecode(t *testing.T) {
+	call := ethmobile.NewEthCall()
+
+	// MKR name() returns bytes32
+	name, err := call.DecodeName("0x4d616b6572000000000000000000000000000000000000000000000000000000")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Maker", name)
+
+	name, err = call.DecodeName("0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"5553445400000000000000000000000000000000000000000000000000000000")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USDT", name)
+
+	decimals, err := call.DecodeDecimals("0x0000000000000000000000000000000000000000000000000000000000000012")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "18", decimals)
+
+	tokens, err := call.DecodeTokensOf("0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"00000000000000000000000000000000000000000000000000000000000003e8")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `["1","1000"]`, tokens)
+
+	lands, err := call.DecodeLandOf("0x" +
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000080" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffb" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000010")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"x":["-5"],"y":["16"]}`, lands)
+
+	exists, err := call.DecodeExists("0x0000000000000000000000000000000000000000000000000000000000000001")
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	owner, err := call.DecodeOwnerOf("0x0000000000000000000000003535353535353535353535353535353535353535")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x3535353535353535353535353535353535353535", owner)
+
+	_, err = call.DecodeBalanceOf("0x")
+
+	assert.Error(t, err)
+
+	contract, err := ethmobile.NewABI(erc20ABI)
+
+	assert.NoError(t, err)
+
+	result, err := contract.Decode("transfer", "0x0000000000000000000000000000000000000000000000000000000000000001")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `[true]`, result)
+
+	result, err = ethmobile.DecodeResult("address,uint256", "0x00000000000000000000000035353535353535353535353535353535353535350000000000000000000000000000000000000000000000000000000000000001")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `["0x3535353535353535353535353535353535353535","1"]`, result)
+}
+
+const transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+func TestDecodeLog(t *testing.T) {
+	topics := `["` + transferTopic + `","0x0000000000000000000000003535353535353535353535353535353535353535","0x0000000000000000000000000000000000000000000000000000000000000001"]`
+
+	result, err := ethmobile.DecodeLog("0x3535353535353535353535353535353535353535", topics, "0x00000000000000000000000000000000000000000000000000000000000003e8")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"address":"0x3535353535353535353535353535353535353535","standard":"erc20","event":"Transfer","signature":"Transfer(address,address,uint256)","args":{"from":"0x3535353535353535353535353535353535353535","to":"0x0000000000000000000000000000000000000001","value":"1000"}}`, result)
+
+	topics = `["` + transferTopic + `","0x0000000000000000000000003535353535353535353535353535353535353535","0x0000000000000000000000000000000000000000000000000000000000000001","0x0000000000000000000000000000000000000000000000000000000000000007"]`
+
+	result, err = ethmobile.DecodeLog("0x3535353535353535353535353535353535353535", topics, "0x")
+
+	assert.NoError(t, err)
+	assert.Contains(t, result, `"standard":"erc721"`)
+	assert.Contains(t, result, `"tokenId":"7"`)
+
+	_, err = ethmobile.DecodeLog("0x3535353535353535353535353535353535353535", `["0x01"]`, "0x")
+
+	assert.Error(t, err)
+
+	// non-standard token emitting Transfer without data
+	_, err = ethmobile.DecodeLog("0x3535353535353535353535353535353535353535", `["`+transferTopic+`","0x0000000000000000000000003535353535353535353535353535353535353535","0x0000000000000000000000000000000000000000000000000000000000000001"]`, "0x")
+
+	assert.Error(t, err)
+
+	contract, err := ethmobile.NewABI(`[{"type":"event","name":"Deposit","inputs":[{"name":"dst","type":"address","indexed":true},{"name":"wad","type":"uint256"}]}]`)
+
+	assert.NoError(t, err)
+
+	depositTopic := "0x" + hex.EncodeToString(ethtx.Keccak256([]byte("Deposit(address,uint256)")))
+
+	receipt := `{"status":"0x1","logs":[
+		{"address":"0x3535353535353535353535353535353535353535","topics":["` + depositTopic + `","0x0000000000000000000000003535353535353535353535353535353535353535"],"data":"0x0000000000000000000000000000000000000000000000000000000000000001"},
+		{"address":"0x3535353535353535353535353535353535353535","topics":["0x0000000000000000000000000000000000000000000000000000000000000001"],"data":"0x"},
+		{"address":"0x3535353535353535353535353535353535353535","topics":["` + transferTopic + `","0x0000000000000000000000003535353535353535353535353535353535353535","0x0000000000000000000000000000000000000000000000000000000000000001"],"data":"0x"},
+		{"address":"0x3535353535353535353535353535353535353535","topics":["` + transferTopic + `","0x0000000000000000000000003535353535353535353535353535353535353535","0x0000000000000000000000000000000000000000000000000000000000000001"],"data":"0x00000000000000000000000000000000000000000000000000000000000003e8"}
+	]}`
+
+	result, err = contract.DecodeReceiptLogs(receipt)
+
+	assert.NoError(t, err)
+
+	var logs []map[string]interface{}
+
+	assert.NoError(t, json.Unmarshal([]byte(result), &logs))
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, "Deposit", logs[0]["event"])
+	assert.Nil(t, logs[0]["standard"])
+	assert.Equal(t, "Transfer", logs[1]["event"])
+	assert.Equal(t, "erc20", logs[1]["standard"])
+
+	result, err = ethmobile.DecodeReceiptLogs(receipt)
+
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(result), &logs))
+	assert.Equal(t, 1, len(logs))
+}
+
+func TestPersonalMessage(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+
+	assert.NoError(t, err)
+
+	// signature produced by web3 accounts.sign and metamask personal_sign
+	signature, err := wallet.SignPersonalMessage("Some data")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c", signature)
+
+	ok, err := ethmobile.VerifyPersonalMessage("0x2c7536e3605d9c16a7a3d7b1898e529396a65c23", "Some data", signature)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = ethmobile.VerifyPersonalMessage(wallet.Address(), "Some other data", signature)
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = ethmobile.VerifyPersonalMessage(wallet.Address(), "Some data", "0x1234")
+
+	assert.Error(t, err)
+
+	signature, err = wallet.SignPersonalMessageBytes([]byte("Some data"))
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signature, "0xb91467e5"))
+
+	// eth_sign of the personal message hash equals personal_sign
+	signature, err = wallet.EthSign("0x1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655")
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signature, "0xb91467e5"))
+
+	_, err = wallet.EthSign("0x1234")
+
+	assert.Error(t, err)
+}
+
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [{"name": "name", "type": "string"}, {"name": "wallet", "type": "address"}],
+		"Mail": [{"name": "from", "type": "Person"}, {"name": "to", "type": "Person"}, {"name": "contents", "type": "string"}]
+	},
+	"primaryType": "Mail",
+	"domain": {"name": "Ether Mail", "version": "1", "chainId": 1, "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestSignTypedData(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey(hex.EncodeToString(ethtx.Keccak256([]byte("cow"))))
+
+	assert.NoError(t, err)
+
+	hash, err := ethmobile.HashTypedData(mailTypedData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hash)
+
+	signature, err := wallet.SignTypedData(mailTypedData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c", signature)
+
+	ok, err := ethmobile.VerifyTypedData("0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826", mailTypedData, signature)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = ethmobile.VerifyTypedData("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB", mailTypedData, signature)
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = wallet.SignTypedData(`{"primaryType":"Mail"}`)
+
+	assert.Error(t, err)
+}
+
+func TestSiweMessage(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+
+	assert.NoError(t, err)
+
+	nonce, err := ethmobile.GenerateSiweNonce()
+
+	assert.NoError(t, err)
+
+	fields, _ := json.Marshal(map[string]interface{}{
+		"domain":    "service.org",
+		"address":   "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23",
+		"statement": "Sign in to service.org",
+		"uri":       "https://service.org/login",
+		"version":   "1",
+		"chainId":   1,
+		"nonce":     nonce,
+		"issuedAt":  "2021-09-30T16:25:24Z",
+		"resources": []string{"https://service.org/profile"},
+	})
+
+	message, err := ethmobile.BuildSiweMessage(string(fields))
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(message, "service.org wants you to sign in with your Ethereum account:\n0x2c7536E3605D9C16a7a3D7b1898e529396a65c23\n\nSign in to service.org\n\nURI: https://service.org/login\n"))
+
+	parsed, err := ethmobile.ParseSiweMessage(message)
+
+	assert.NoError(t, err)
+
+	var decoded map[string]interface{}
+
+	assert.NoError(t, json.Unmarshal([]byte(parsed), &decoded))
+	assert.Equal(t, nonce, decoded["nonce"])
+
+	signature, err := wallet.SignSiweMessage(message)
+
+	assert.NoError(t, err)
+
+	ok, err := ethmobile.VerifyPersonalMessage(wallet.Address(), message, signature)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.NoError(t, ethmobile.VerifySiweMessage(message, signature, "service.org", nonce))
+	assert.Equal(t, siwe.ErrDomain, ethmobile.VerifySiweMessage(message, signature, "evil.org", ""))
+	assert.Equal(t, siwe.ErrDomainRequired, ethmobile.VerifySiweMessage(message, signature, "", ""))
+
+	other, err := ethmobile.FromMnemonicAccount(testMnemonic, "en_US", 0)
+
+	assert.NoError(t, err)
+
+	_, err = other.SignSiweMessage(message)
+
+	assert.Error(t, err)
+
+	_, err = ethmobile.BuildSiweMessage(`{"domain":"service.org"}`)
+
+	assert.Error(t, err)
+}
+
+func TestDecodeTransactionOversizedSignature(t *testing.T) {
+	to, _ := ethtx.ParseAddress("0x3535353535353535353535353535353535353535")
+
+	tx := ethtx.NewLegacyTx(big.NewInt(1), 9, to, big.NewInt(1), big.NewInt(1), big.NewInt(21000), nil)
+
+	tx.V = big.NewInt(37)
+	tx.R = new(big.Int).Lsh(big.NewInt(1), 264)
+	tx.S = big.NewInt(1)
+
+	raw, err := tx.Encode()
+
+	assert.NoError(t, err)
+
+	_, err = ethmobile.DecodeTransaction("0x" + hex.EncodeToString(raw))
+
+	assert.Equal(t, ethtx.ErrSignatureValues, err)
+}
+
+func TestEmptyRecipient(t *testing.T) {
+	wallet, err := ethmobile.FromPrivateKey("4646464646464646464646464646464646464646464646464646464646464646")
+
+	assert.NoError(t, err)
+
+	_, err = wallet.Transfer("0x1", "0x0", "", "0xde0b6b3a7640000", "0x4a817c800", "0x5208")
+
+	assert.Error(t, err)
+
+	_, err = wallet.TransferERC20("0x1", "", "0x0", "0x3535353535353535353535353535353535353535", "0x3e8", "0x4a817c800", "0xea60")
+
+	assert.Error(t, err)
+
+	_, err = wallet.TransferEIP1559("0x1", "0x0", "", "0x1", "0x1", "0x2", "0x5208", "")
+
+	assert.Error(t, err)
+
+	_, err = wallet.TransferEIP2930("0x1", "0x0", "", "0x1", "0x1", "0x5208", "")
+
+	assert.Error(t, err)
+
+	_, err = wallet.CallContract("0x1", "", "0x0", "0x0", "0xa9059cbb", "0x1", "0xea60")
+
+	assert.Error(t, err)
+
+	_, err = wallet.CallContractEIP1559("0x1", "", "0x0", "0x0", "0xa9059cbb", "0x1", "0x2", "0xea60", "")
+
+	assert.Error(t, err)
+
+	tx, err := wallet.DeployContract("0x1", "0x0", "0x6080604052", "", "0x0", "0x1", "0x30d40")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tx.ContractAddress)
+
+	tx, err = wallet.DeployContractEIP1559("0x1", "0x0", "0x6080604052", "", "0x0", "0x1", "0x2", "0x30d40", "")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tx.ContractAddress)
+}
@@ -0,0 +1,69 @@
+package ethmobile
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/inwecrypto/mobilesdk/eip712"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// HashTypedData eip-712 signing hash of eth_signTypedData_v4 json
+func HashTypedData(typedData string) (string, error) {
+	hash, err := hashTypedData(typedData)
+
+	if err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(hash), nil
+}
+
+// SignTypedData eth_signTypedData_v4, returns 0x prefixed 65 bytes signature r || s || v
+func (wallet *Wallet) SignTypedData(typedData string) (string, error) {
+	hash, err := hashTypedData(typedData)
+
+	if err != nil {
+		return "", err
+	}
+
+	return wallet.signHash(hash)
+}
+
+// RecoverTypedData recover signer address of eth_signTypedData_v4 signature
+func RecoverTypedData(typedData string, signature string) (string, error) {
+	hash, err := hashTypedData(typedData)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := readHex(signature)
+
+	if err != nil {
+		return "", err
+	}
+
+	return ethtx.RecoverSigner(hash, data)
+}
+
+// VerifyTypedData check if eth_signTypedData_v4 signature is signed by address
+func VerifyTypedData(address string, typedData string, signature string) (bool, error) {
+	signer, err := RecoverTypedData(typedData, signature)
+
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(signer, address), nil
+}
+
+func hashTypedData(typedData string) ([]byte, error) {
+	parsed, err := eip712.Parse([]byte(typedData))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed.Hash()
+}
@@ -0,0 +1,174 @@
+package ethtx
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/inwecrypto/ethgo/rpc"
+	"github.com/ybbus/jsonrpc"
+)
+
+// AccessTuple eip-2930 access list entry
+type AccessTuple struct {
+	Address     [20]byte
+	StorageKeys [][32]byte
+}
+
+// AccessList eip-2930 access list
+type AccessList []AccessTuple
+
+type accessTupleJSON struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storageKeys"`
+}
+
+// ParseAccessList parse json access list like [{"address":"0x..","storageKeys":["0x.."]}],
+// empty string means no access list
+func ParseAccessList(data string) (AccessList, error) {
+	data = strings.TrimSpace(data)
+
+	if data == "" || data == "null" {
+		return nil, nil
+	}
+
+	var list AccessList
+
+	if err := json.Unmarshal([]byte(data), &list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UnmarshalJSON implement json.Unmarshaler
+func (tuple *AccessTuple) UnmarshalJSON(data []byte) error {
+	var tupleJSON accessTupleJSON
+
+	if err := json.Unmarshal(data, &tupleJSON); err != nil {
+		return err
+	}
+
+	address, err := ParseAddress(tupleJSON.Address)
+
+	if err != nil {
+		return err
+	}
+
+	if address == nil {
+		return fmt.Errorf("ethtx: access list entry without address")
+	}
+
+	tuple.Address = *address
+	tuple.StorageKeys = make([][32]byte, 0, len(tupleJSON.StorageKeys))
+
+	for _, key := range tupleJSON.StorageKeys {
+		bytes, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+
+		if err != nil || len(bytes) != 32 {
+			return fmt.Errorf("ethtx: invalid storage key %s", key)
+		}
+
+		var storageKey [32]byte
+
+		copy(storageKey[:], bytes)
+
+		tuple.StorageKeys = append(tuple.StorageKeys, storageKey)
+	}
+
+	return nil
+}
+
+// MarshalJSON implement json.Marshaler
+func (tuple AccessTuple) MarshalJSON() ([]byte, error) {
+	tupleJSON := accessTupleJSON{
+		Address:     ChecksumAddress(tuple.Address[:]),
+		StorageKeys: make([]string, 0, len(tuple.StorageKeys)),
+	}
+
+	for _, key := range tuple.StorageKeys {
+		tupleJSON.StorageKeys = append(tupleJSON.StorageKeys, "0x"+hex.EncodeToString(key[:]))
+	}
+
+	return json.Marshal(tupleJSON)
+}
+
+// AccessListResult eth_createAccessList result
+type AccessListResult struct {
+	AccessList AccessList `json:"accessList"`
+	GasUsed    string     `json:"gasUsed"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// ParseAccessListResult parse eth_createAccessList result json
+func ParseAccessListResult(data string) (*AccessListResult, error) {
+	var result *AccessListResult
+
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, fmt.Errorf("ethtx: empty eth_createAccessList result")
+	}
+
+	if result.Error != "" {
+		return nil, fmt.Errorf("ethtx: eth_createAccessList failed: %s", result.Error)
+	}
+
+	return result, nil
+}
+
+// CreateAccessList call eth_createAccessList of node at endpoint, block can be "latest" or "pending".
+// rpc.Client has no eth_createAccessList method and its generic call is unexported, so
+// the request is sent by the same jsonrpc client it wraps, with the same error format
+func CreateAccessList(endpoint string, site *rpc.CallSite, block string) (AccessList, *big.Int, error) {
+	response, err := jsonrpc.NewRPCClient(endpoint).Call("eth_createAccessList", site, block)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if response.Error != nil {
+		return nil, nil, fmt.Errorf("rpc error : %d %s %v", response.Error.Code, response.Error.Message, response.Error.Data)
+	}
+
+	data, err := json.Marshal(response.Result)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := ParseAccessListResult(string(data))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gasUsed, ok := new(big.Int).SetString(strings.TrimPrefix(result.GasUsed, "0x"), 16)
+
+	if !ok {
+		return nil, nil, fmt.Errorf("ethtx: invalid gasUsed %s", result.GasUsed)
+	}
+
+	return result.AccessList, gasUsed, nil
+}
+
+// rlpList access list as rlp encodable list: [[address, [storageKey, ...]], ...]
+func (list AccessList) rlpList() []interface{} {
+	result := make([]interface{}, 0, len(list))
+
+	for _, tuple := range list {
+		keys := make([]interface{}, 0, len(tuple.StorageKeys))
+
+		for _, key := range tuple.StorageKeys {
+			keys = append(keys, key)
+		}
+
+		result = append(result, []interface{}{tuple.Address, keys})
+	}
+
+	return result
+}
@@ -0,0 +1,103 @@
+package ethtx
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/inwecrypto/ethgo/rlp"
+)
+
+// AccessListTxType eip-2930 transaction type
+const AccessListTxType = 0x01
+
+// AccessListTx eip-2930 access list transaction
+type AccessListTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasPrice   *big.Int
+	GasLimit   *big.Int
+	To         *[20]byte // nil means contract creation
+	Value      *big.Int
+	Data       []byte
+	AccessList AccessList
+	V          *big.Int // y parity
+	R          *big.Int
+	S          *big.Int
+}
+
+// NewAccessListTx create eip-2930 transaction of chainID
+func NewAccessListTx(chainID *big.Int, nonce uint64, to *[20]byte, value, gasPrice, gasLimit *big.Int, data []byte, accessList AccessList) *AccessListTx {
+	return &AccessListTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		GasPrice:   orZero(gasPrice),
+		GasLimit:   orZero(gasLimit),
+		To:         to,
+		Value:      orZero(value),
+		Data:       data,
+		AccessList: accessList,
+		V:          new(big.Int),
+		R:          new(big.Int),
+		S:          new(big.Int),
+	}
+}
+
+func (tx *AccessListTx) fields() []interface{} {
+	return []interface{}{
+		tx.ChainID,
+		tx.Nonce,
+		tx.GasPrice,
+		tx.GasLimit,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList.rlpList(),
+	}
+}
+
+// SigningHash keccak256(0x01 || rlp([chainId, nonce, gasPrice, gas, to, value, data, accessList]))
+func (tx *AccessListTx) SigningHash() ([]byte, error) {
+	if tx.ChainID == nil || tx.ChainID.Sign() <= 0 {
+		return nil, ErrChainID
+	}
+
+	data, err := rlp.EncodeToBytes(tx.fields())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256([]byte{AccessListTxType}, data), nil
+}
+
+// Sign sign transaction, V is the y parity of signature
+func (tx *AccessListTx) Sign(prv *ecdsa.PrivateKey) error {
+	hash, err := tx.SigningHash()
+
+	if err != nil {
+		return err
+	}
+
+	recid, r, s, err := sign(hash, prv)
+
+	if err != nil {
+		return err
+	}
+
+	tx.V = big.NewInt(int64(recid))
+	tx.R = r
+	tx.S = s
+
+	return nil
+}
+
+// Encode typed envelope of signed transaction: 0x01 || rlp([..., accessList, yParity, r, s])
+func (tx *AccessListTx) Encode() ([]byte, error) {
+	data, err := rlp.EncodeToBytes(append(tx.fields(), tx.V, tx.R, tx.S))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{AccessListTxType}, data...), nil
+}
@@ -0,0 +1,196 @@
+package ethtx
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Errors
+var (
+	ErrUnknownMethod = errors.New("ethtx: unknown method")
+	ErrCallData      = errors.New("ethtx: malformed call data")
+)
+
+// Call decoded contract call
+type Call struct {
+	Method    string     `json:"method"`
+	Signature string     `json:"signature"`
+	Arguments []Argument `json:"arguments"`
+}
+
+// Argument decoded call argument, integers are decimal strings, addresses are
+// checksummed, bytes are 0x prefixed hex
+type Argument struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type knownMethod struct {
+	signature string
+	names     []string
+}
+
+// erc20, erc721, decentraland and red packet methods
+var knownMethods = []knownMethod{
+	{"transfer(address,uint256)", []string{"to", "value"}},
+	{"approve(address,uint256)", []string{"spender", "value"}},
+	// erc721 transferFrom shares selector with erc20, value is the token id
+	{"transferFrom(address,address,uint256)", []string{"from", "to", "value"}},
+	{"transferOwnership(address)", []string{"newOwner"}},
+	{"safeTransferFrom(address,address,uint256)", []string{"from", "to", "tokenId"}},
+	{"safeTransferFrom(address,address,uint256,bytes)", []string{"from", "to", "tokenId", "data"}},
+	{"setApprovalForAll(address,bool)", []string{"operator", "approved"}},
+	{"takeOwnership(uint256)", []string{"tokenId"}},
+	{"setAssetHolder(address,uint256)", []string{"to", "tokenId"}},
+	{"transferLand(int256,int256,address)", []string{"x", "y", "to"}},
+	{"newRedPacket(uint256,address,address,uint256,uint256,uint256)", []string{"tokenId", "token", "from", "value", "count", "command"}},
+	{"openMany(uint256,address[],uint256,bool)", []string{"tokenId", "addresses", "command", "end"}},
+	{"sendEther(uint256)", []string{"tokenId"}},
+	{"setTaxCost(uint256,uint256)", []string{"min", "max"}},
+	{"changeWallet(address)", []string{"wallet"}},
+	{"changeMaxCount(uint256)", []string{"count"}},
+	{"changeGatherValue(uint256)", []string{"value"}},
+	{"addAdmin(address)", []string{"admin"}},
+	{"delAdmin(address)", []string{"admin"}},
+}
+
+var knownSelectors = make(map[string]knownMethod)
+
+func init() {
+	for _, method := range knownMethods {
+		knownSelectors[string(Keccak256([]byte(method.signature))[:4])] = method
+	}
+}
+
+// DecodeCallData decode call data of known erc20, erc721, decentraland and red packet
+// methods, returns ErrUnknownMethod for other selectors
+func DecodeCallData(data []byte) (*Call, error) {
+	if len(data) < 4 {
+		return nil, ErrUnknownMethod
+	}
+
+	method, ok := knownSelectors[string(data[:4])]
+
+	if !ok {
+		return nil, ErrUnknownMethod
+	}
+
+	start := strings.Index(method.signature, "(")
+
+	types := strings.Split(method.signature[start+1:len(method.signature)-1], ",")
+
+	call := &Call{
+		Method:    method.signature[:start],
+		Signature: method.signature,
+	}
+
+	args := data[4:]
+
+	for i, typ := range types {
+		value, err := decodeArgument(args, i, typ)
+
+		if err != nil {
+			return nil, err
+		}
+
+		call.Arguments = append(call.Arguments, Argument{
+			Name:  method.names[i],
+			Type:  typ,
+			Value: value,
+		})
+	}
+
+	return call, nil
+}
+
+func decodeArgument(args []byte, index int, typ string) (interface{}, error) {
+	word, err := callWord(args, index*32)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case "address":
+		return ChecksumAddress(word[12:]), nil
+	case "uint256":
+		return new(big.Int).SetBytes(word).String(), nil
+	case "int256":
+		value := new(big.Int).SetBytes(word)
+
+		if word[0]&0x80 != 0 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+
+		return value.String(), nil
+	case "bool":
+		return word[31] != 0, nil
+	}
+
+	// dynamic types: word is the offset of length prefixed content
+	offset, length, err := callDynamic(args, word)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case "bytes":
+		if offset+length > len(args) {
+			return nil, ErrCallData
+		}
+
+		return "0x" + hex.EncodeToString(args[offset:offset+length]), nil
+	case "address[]":
+		addresses := make([]string, 0, length)
+
+		for i := 0; i < length; i++ {
+			item, err := callWord(args, offset+i*32)
+
+			if err != nil {
+				return nil, err
+			}
+
+			addresses = append(addresses, ChecksumAddress(item[12:]))
+		}
+
+		return addresses, nil
+	}
+
+	return nil, fmt.Errorf("ethtx: unsupported argument type %s", typ)
+}
+
+func callWord(args []byte, offset int) ([]byte, error) {
+	if offset < 0 || offset+32 > len(args) {
+		return nil, ErrCallData
+	}
+
+	return args[offset : offset+32], nil
+}
+
+// callDynamic read offset word, returns content offset and length
+func callDynamic(args []byte, word []byte) (int, int, error) {
+	offset := new(big.Int).SetBytes(word)
+
+	if !offset.IsInt64() || offset.Int64() > int64(len(args)) {
+		return 0, 0, ErrCallData
+	}
+
+	lengthWord, err := callWord(args, int(offset.Int64()))
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	length := new(big.Int).SetBytes(lengthWord)
+
+	if !length.IsInt64() || length.Int64() > int64(len(args)) {
+		return 0, 0, ErrCallData
+	}
+
+	return int(offset.Int64()) + 32, int(length.Int64()), nil
+}
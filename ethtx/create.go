@@ -0,0 +1,31 @@
+package ethtx
+
+import (
+	"github.com/inwecrypto/ethgo/rlp"
+)
+
+// CreateAddress address of contract deployed by sender with nonce:
+// keccak256(rlp([sender, nonce]))[12:]
+func CreateAddress(sender [20]byte, nonce uint64) ([20]byte, error) {
+	var address [20]byte
+
+	data, err := rlp.EncodeToBytes([]interface{}{sender, nonce})
+
+	if err != nil {
+		return address, err
+	}
+
+	copy(address[:], Keccak256(data)[12:])
+
+	return address, nil
+}
+
+// Create2Address address of contract deployed by factory with CREATE2:
+// keccak256(0xff || factory || salt || keccak256(initCode))[12:]
+func Create2Address(factory [20]byte, salt [32]byte, initCode []byte) [20]byte {
+	var address [20]byte
+
+	copy(address[:], Keccak256([]byte{0xff}, factory[:], salt[:], Keccak256(initCode))[12:])
+
+	return address
+}
@@ -0,0 +1,272 @@
+package ethtx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/inwecrypto/ethgo/rlp"
+)
+
+// LegacyTxType type of legacy transactions, which have no typed envelope
+const LegacyTxType = 0x00
+
+// Errors
+var (
+	ErrTxType          = errors.New("ethtx: unsupported transaction type")
+	ErrTxFields        = errors.New("ethtx: unexpected transaction field count")
+	ErrUnsigned        = errors.New("ethtx: transaction is not signed")
+	ErrSignature       = errors.New("ethtx: invalid signature v")
+	ErrSignatureValues = errors.New("ethtx: invalid signature r or s")
+)
+
+// Transaction decoded transaction of any supported type, fields not used by the
+// type are nil
+type Transaction struct {
+	Type       byte
+	ChainID    *big.Int // nil for legacy tx without eip-155 replay protection
+	Nonce      uint64
+	GasPrice   *big.Int // legacy and eip-2930
+	GasTipCap  *big.Int // eip-1559 maxPriorityFeePerGas
+	GasFeeCap  *big.Int // eip-1559 maxFeePerGas
+	GasLimit   *big.Int
+	To         *[20]byte // nil means contract creation
+	Value      *big.Int
+	Data       []byte
+	AccessList AccessList
+	V          *big.Int // nil if unsigned
+	R          *big.Int
+	S          *big.Int
+	Raw        []byte
+}
+
+// DecodeTransaction decode raw legacy, eip-155, eip-2930 or eip-1559 transaction,
+// signed or unsigned. Unsigned legacy tx is either [nonce, gasPrice, gas, to, value, data]
+// or eip-155 signing form [..., chainId, 0, 0]
+func DecodeTransaction(raw []byte) (*Transaction, error) {
+	if len(raw) == 0 {
+		return nil, ErrTxFields
+	}
+
+	tx := &Transaction{
+		Raw: raw,
+	}
+
+	// rlp list prefix starts from 0xc0, anything below is a typed envelope
+	if raw[0] >= 0xc0 {
+		tx.Type = LegacyTxType
+
+		return tx, tx.decodeLegacy(raw)
+	}
+
+	tx.Type = raw[0]
+
+	switch tx.Type {
+	case AccessListTxType:
+		return tx, tx.decodeTyped(raw[1:], []interface{}{&tx.ChainID, &tx.Nonce, &tx.GasPrice, &tx.GasLimit})
+	case DynamicFeeTxType:
+		return tx, tx.decodeTyped(raw[1:], []interface{}{&tx.ChainID, &tx.Nonce, &tx.GasTipCap, &tx.GasFeeCap, &tx.GasLimit})
+	default:
+		return nil, ErrTxType
+	}
+}
+
+func (tx *Transaction) decodeLegacy(raw []byte) error {
+	var fields []rlp.RawValue
+
+	if err := rlp.DecodeBytes(raw, &fields); err != nil {
+		return err
+	}
+
+	if len(fields) != 6 && len(fields) != 9 {
+		return ErrTxFields
+	}
+
+	if err := decodeFields(fields[:3], &tx.Nonce, &tx.GasPrice, &tx.GasLimit); err != nil {
+		return err
+	}
+
+	if err := tx.decodePayload(fields[3:6]); err != nil {
+		return err
+	}
+
+	if len(fields) == 6 {
+		return nil
+	}
+
+	var v, r, s *big.Int
+
+	if err := decodeFields(fields[6:], &v, &r, &s); err != nil {
+		return err
+	}
+
+	// eip-155 signing form carries chain id in v with empty r and s
+	if r.Sign() == 0 && s.Sign() == 0 {
+		tx.ChainID = v
+
+		return nil
+	}
+
+	if err := checkSignatureValues(r, s); err != nil {
+		return err
+	}
+
+	tx.V, tx.R, tx.S = v, r, s
+
+	if v.Cmp(big.NewInt(35)) >= 0 {
+		tx.ChainID = new(big.Int).Rsh(new(big.Int).Sub(v, big.NewInt(35)), 1)
+	} else if v.Cmp(big.NewInt(27)) != 0 && v.Cmp(big.NewInt(28)) != 0 {
+		return ErrSignature
+	}
+
+	return nil
+}
+
+func (tx *Transaction) decodeTyped(raw []byte, head []interface{}) error {
+	var fields []rlp.RawValue
+
+	if err := rlp.DecodeBytes(raw, &fields); err != nil {
+		return err
+	}
+
+	// head, to, value, data, accessList and optional signature
+	count := len(head) + 4
+
+	if len(fields) != count && len(fields) != count+3 {
+		return ErrTxFields
+	}
+
+	if err := decodeFields(fields[:len(head)], head...); err != nil {
+		return err
+	}
+
+	if err := tx.decodePayload(fields[len(head) : len(head)+3]); err != nil {
+		return err
+	}
+
+	if err := rlp.DecodeBytes(fields[count-1], &tx.AccessList); err != nil {
+		return err
+	}
+
+	if len(fields) == count {
+		return nil
+	}
+
+	if err := decodeFields(fields[count:], &tx.V, &tx.R, &tx.S); err != nil {
+		return err
+	}
+
+	if tx.V.Cmp(big.NewInt(1)) > 0 {
+		return ErrSignature
+	}
+
+	return checkSignatureValues(tx.R, tx.S)
+}
+
+// checkSignatureValues r and s must be non-zero and fit in 32 bytes
+func checkSignatureValues(r, s *big.Int) error {
+	if r.Sign() == 0 || s.Sign() == 0 || r.BitLen() > 256 || s.BitLen() > 256 {
+		return ErrSignatureValues
+	}
+
+	return nil
+}
+
+// decodePayload decode to, value and data fields
+func (tx *Transaction) decodePayload(fields []rlp.RawValue) error {
+	var to []byte
+
+	if err := decodeFields(fields, &to, &tx.Value, &tx.Data); err != nil {
+		return err
+	}
+
+	switch len(to) {
+	case 0:
+	case 20:
+		tx.To = new([20]byte)
+		copy(tx.To[:], to)
+	default:
+		return fmt.Errorf("ethtx: invalid recipient length %d", len(to))
+	}
+
+	return nil
+}
+
+func decodeFields(fields []rlp.RawValue, values ...interface{}) error {
+	for i, value := range values {
+		if err := rlp.DecodeBytes(fields[i], value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Signed check if transaction carries signature
+func (tx *Transaction) Signed() bool {
+	return tx.V != nil
+}
+
+// Hash transaction hash, keccak256 of raw tx
+func (tx *Transaction) Hash() []byte {
+	return Keccak256(tx.Raw)
+}
+
+// SigningHash hash signed by sender
+func (tx *Transaction) SigningHash() ([]byte, error) {
+	switch tx.Type {
+	case AccessListTxType:
+		return NewAccessListTx(tx.ChainID, tx.Nonce, tx.To, tx.Value, tx.GasPrice, tx.GasLimit, tx.Data, tx.AccessList).SigningHash()
+	case DynamicFeeTxType:
+		return NewDynamicFeeTx(tx.ChainID, tx.Nonce, tx.To, tx.Value, tx.GasTipCap, tx.GasFeeCap, tx.GasLimit, tx.Data, tx.AccessList).SigningHash()
+	}
+
+	if tx.ChainID != nil {
+		return NewLegacyTx(tx.ChainID, tx.Nonce, tx.To, tx.Value, tx.GasPrice, tx.GasLimit, tx.Data).SigningHash()
+	}
+
+	data, err := rlp.EncodeToBytes([]interface{}{
+		tx.Nonce,
+		tx.GasPrice,
+		tx.GasLimit,
+		tx.To,
+		tx.Value,
+		tx.Data,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256(data), nil
+}
+
+// Sender recover checksummed sender address from signature
+func (tx *Transaction) Sender() (string, error) {
+	if !tx.Signed() {
+		return "", ErrUnsigned
+	}
+
+	hash, err := tx.SigningHash()
+
+	if err != nil {
+		return "", err
+	}
+
+	recid := new(big.Int).Set(tx.V)
+
+	if tx.Type == LegacyTxType {
+		if tx.ChainID != nil {
+			recid.Sub(recid, new(big.Int).Lsh(tx.ChainID, 1))
+			recid.Sub(recid, big.NewInt(35))
+		} else {
+			recid.Sub(recid, big.NewInt(27))
+		}
+	}
+
+	if recid.Sign() < 0 || recid.Cmp(big.NewInt(1)) > 0 {
+		return "", ErrSignature
+	}
+
+	return RecoverAddress(hash, byte(recid.Int64()), tx.R, tx.S)
+}
@@ -0,0 +1,106 @@
+package ethtx
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/inwecrypto/ethgo/rlp"
+)
+
+// DynamicFeeTxType eip-1559 transaction type
+const DynamicFeeTxType = 0x02
+
+// DynamicFeeTx eip-1559 dynamic fee transaction
+type DynamicFeeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int // maxPriorityFeePerGas
+	GasFeeCap  *big.Int // maxFeePerGas
+	GasLimit   *big.Int
+	To         *[20]byte // nil means contract creation
+	Value      *big.Int
+	Data       []byte
+	AccessList AccessList
+	V          *big.Int // y parity
+	R          *big.Int
+	S          *big.Int
+}
+
+// NewDynamicFeeTx create eip-1559 transaction of chainID
+func NewDynamicFeeTx(chainID *big.Int, nonce uint64, to *[20]byte, value, gasTipCap, gasFeeCap, gasLimit *big.Int, data []byte, accessList AccessList) *DynamicFeeTx {
+	return &DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		GasTipCap:  orZero(gasTipCap),
+		GasFeeCap:  orZero(gasFeeCap),
+		GasLimit:   orZero(gasLimit),
+		To:         to,
+		Value:      orZero(value),
+		Data:       data,
+		AccessList: accessList,
+		V:          new(big.Int),
+		R:          new(big.Int),
+		S:          new(big.Int),
+	}
+}
+
+func (tx *DynamicFeeTx) fields() []interface{} {
+	return []interface{}{
+		tx.ChainID,
+		tx.Nonce,
+		tx.GasTipCap,
+		tx.GasFeeCap,
+		tx.GasLimit,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList.rlpList(),
+	}
+}
+
+// SigningHash keccak256(0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gas, to, value, data, accessList]))
+func (tx *DynamicFeeTx) SigningHash() ([]byte, error) {
+	if tx.ChainID == nil || tx.ChainID.Sign() <= 0 {
+		return nil, ErrChainID
+	}
+
+	data, err := rlp.EncodeToBytes(tx.fields())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256([]byte{DynamicFeeTxType}, data), nil
+}
+
+// Sign sign transaction, V is the y parity of signature
+func (tx *DynamicFeeTx) Sign(prv *ecdsa.PrivateKey) error {
+	hash, err := tx.SigningHash()
+
+	if err != nil {
+		return err
+	}
+
+	recid, r, s, err := sign(hash, prv)
+
+	if err != nil {
+		return err
+	}
+
+	tx.V = big.NewInt(int64(recid))
+	tx.R = r
+	tx.S = s
+
+	return nil
+}
+
+// Encode typed envelope of signed transaction: 0x02 || rlp([..., accessList, yParity, r, s])
+func (tx *DynamicFeeTx) Encode() ([]byte, error) {
+	data, err := rlp.EncodeToBytes(append(tx.fields(), tx.V, tx.R, tx.S))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{DynamicFeeTxType}, data...), nil
+}
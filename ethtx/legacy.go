@@ -0,0 +1,108 @@
+package ethtx
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/inwecrypto/ethgo/rlp"
+)
+
+// LegacyTx legacy eth transaction signed with eip-155 replay protection
+type LegacyTx struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	GasLimit *big.Int
+	To       *[20]byte // nil means contract creation
+	Value    *big.Int
+	Data     []byte
+	ChainID  *big.Int
+	V        *big.Int
+	R        *big.Int
+	S        *big.Int
+}
+
+// NewLegacyTx create legacy transaction of chainID
+func NewLegacyTx(chainID *big.Int, nonce uint64, to *[20]byte, value, gasPrice, gasLimit *big.Int, data []byte) *LegacyTx {
+	return &LegacyTx{
+		Nonce:    nonce,
+		GasPrice: orZero(gasPrice),
+		GasLimit: orZero(gasLimit),
+		To:       to,
+		Value:    orZero(value),
+		Data:     data,
+		ChainID:  chainID,
+		V:        new(big.Int),
+		R:        new(big.Int),
+		S:        new(big.Int),
+	}
+}
+
+// SigningHash eip-155 signing hash: keccak256(rlp([nonce, gasPrice, gas, to, value, data, chainId, 0, 0]))
+func (tx *LegacyTx) SigningHash() ([]byte, error) {
+	if tx.ChainID == nil || tx.ChainID.Sign() <= 0 {
+		return nil, ErrChainID
+	}
+
+	data, err := rlp.EncodeToBytes([]interface{}{
+		tx.Nonce,
+		tx.GasPrice,
+		tx.GasLimit,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.ChainID,
+		uint(0),
+		uint(0),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256(data), nil
+}
+
+// Sign sign transaction, V = recid + chainId * 2 + 35
+func (tx *LegacyTx) Sign(prv *ecdsa.PrivateKey) error {
+	hash, err := tx.SigningHash()
+
+	if err != nil {
+		return err
+	}
+
+	recid, r, s, err := sign(hash, prv)
+
+	if err != nil {
+		return err
+	}
+
+	tx.V = new(big.Int).Mul(tx.ChainID, big.NewInt(2))
+	tx.V.Add(tx.V, big.NewInt(int64(recid)+35))
+	tx.R = r
+	tx.S = s
+
+	return nil
+}
+
+// Encode rlp encode signed transaction
+func (tx *LegacyTx) Encode() ([]byte, error) {
+	return rlp.EncodeToBytes([]interface{}{
+		tx.Nonce,
+		tx.GasPrice,
+		tx.GasLimit,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.V,
+		tx.R,
+		tx.S,
+	})
+}
+
+func orZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+
+	return value
+}
@@ -0,0 +1,57 @@
+package ethtx
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrSignatureLength signature is not 65 bytes r || s || v
+var ErrSignatureLength = errors.New("ethtx: signature must be 65 bytes")
+
+// PersonalMessageHash eip-191 personal message hash:
+// keccak256("\x19Ethereum Signed Message:\n" + len(message) + message)
+func PersonalMessageHash(message []byte) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))
+
+	return Keccak256([]byte(prefix), message)
+}
+
+// SignHash sign 32 bytes hash, returns 65 bytes signature r || s || v with v 27 or 28
+func SignHash(hash []byte, prv *ecdsa.PrivateKey) ([]byte, error) {
+	recid, r, s, err := sign(hash, prv)
+
+	if err != nil {
+		return nil, err
+	}
+
+	signature := make([]byte, 65)
+
+	copy(signature[32-len(r.Bytes()):32], r.Bytes())
+	copy(signature[64-len(s.Bytes()):64], s.Bytes())
+
+	signature[64] = recid + 27
+
+	return signature, nil
+}
+
+// RecoverSigner recover checksummed signer address of hash from 65 bytes signature,
+// v can be 0, 1 or 27, 28
+func RecoverSigner(hash []byte, signature []byte) (string, error) {
+	if len(signature) != 65 {
+		return "", ErrSignatureLength
+	}
+
+	recid := signature[64]
+
+	if recid >= 27 {
+		recid -= 27
+	}
+
+	if recid > 1 {
+		return "", ErrSignature
+	}
+
+	return RecoverAddress(hash, recid, new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:64]))
+}
@@ -0,0 +1,125 @@
+package ethtx
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/inwecrypto/ethgo/math"
+	"github.com/inwecrypto/gosecp256k1"
+	"github.com/inwecrypto/sha3"
+)
+
+// Errors
+var (
+	ErrChainID = errors.New("ethtx: chain id is required for replay protection")
+)
+
+// Keccak256 keccak256 hash of data
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewKeccak256()
+
+	for _, d := range data {
+		hasher.Write(d)
+	}
+
+	return hasher.Sum(nil)
+}
+
+// ParseAddress parse hex address into 20 bytes, empty address returns nil which
+// means contract creation
+func ParseAddress(address string) (*[20]byte, error) {
+	address = strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X")
+
+	if address == "" {
+		return nil, nil
+	}
+
+	data, err := hex.DecodeString(address)
+
+	if err != nil || len(data) != 20 {
+		return nil, fmt.Errorf("ethtx: invalid address %s", address)
+	}
+
+	var result [20]byte
+
+	copy(result[:], data)
+
+	return &result, nil
+}
+
+// sign sign 32 bytes hash, returns recovery id and r, s
+func sign(hash []byte, prv *ecdsa.PrivateKey) (byte, *big.Int, *big.Int, error) {
+	seckey := math.PaddedBigBytes(prv.D, prv.Params().BitSize/8)
+
+	defer zeroBytes(seckey)
+
+	sig, err := secp256k1.Sign(hash, seckey)
+
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return sig[64], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), nil
+}
+
+func zeroBytes(bytes []byte) {
+	for i := range bytes {
+		bytes[i] = 0
+	}
+}
+
+// RecoverAddress recover checksummed signer address from hash and signature
+func RecoverAddress(hash []byte, recid byte, r, s *big.Int) (string, error) {
+	if r == nil || s == nil || r.Sign() <= 0 || s.Sign() <= 0 || r.BitLen() > 256 || s.BitLen() > 256 {
+		return "", ErrSignatureValues
+	}
+
+	sig := make([]byte, 65)
+
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(s.Bytes()):64], s.Bytes())
+
+	sig[64] = recid
+
+	pubkey, err := secp256k1.RecoverPubkey(hash, sig)
+
+	if err != nil {
+		return "", err
+	}
+
+	return PubkeyToAddress(pubkey), nil
+}
+
+// PubkeyToAddress convert 65 bytes uncompressed public key to eip-55 checksummed address
+func PubkeyToAddress(pubkey []byte) string {
+	return ChecksumAddress(Keccak256(pubkey[1:])[12:])
+}
+
+// ChecksumAddress eip-55 mixed case hex address
+func ChecksumAddress(address []byte) string {
+	unchecksummed := hex.EncodeToString(address)
+
+	hash := Keccak256([]byte(unchecksummed))
+
+	result := []byte(unchecksummed)
+
+	for i := range result {
+		hashByte := hash[i/2]
+
+		if i%2 == 0 {
+			hashByte = hashByte >> 4
+		} else {
+			hashByte &= 0xf
+		}
+
+		if result[i] > '9' && hashByte > 7 {
+			result[i] -= 32
+		}
+	}
+
+	return "0x" + string(result)
+}
@@ -0,0 +1,104 @@
+package ethtxtest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/inwecrypto/ethgo/keystore"
+	"github.com/inwecrypto/ethgo/rlp"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/stretchr/testify/assert"
+)
+
+const accessListJSON = `[{"address":"0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000003","0x0000000000000000000000000000000000000000000000000000000000000007"]},{"address":"0xbb9bc244d798123fde783fcc1c72d3bb8c189413","storageKeys":[]}]`
+
+func TestParseAccessList(t *testing.T) {
+	list, err := ethtx.ParseAccessList(accessListJSON)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, 2, len(list[0].StorageKeys))
+	assert.Equal(t, byte(7), list[0].StorageKeys[1][31])
+	assert.Equal(t, 0, len(list[1].StorageKeys))
+
+	data, err := json.Marshal(list)
+
+	assert.NoError(t, err)
+
+	assert.Equal(t, strings.ToLower(accessListJSON), strings.ToLower(string(data)))
+
+	list, err = ethtx.ParseAccessList("")
+
+	assert.NoError(t, err)
+	assert.Nil(t, list)
+
+	_, err = ethtx.ParseAccessList(`[{"address":"0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae","storageKeys":["0x03"]}]`)
+
+	assert.Error(t, err)
+
+	_, err = ethtx.ParseAccessList(`[{"storageKeys":[]}]`)
+
+	assert.Error(t, err)
+}
+
+func TestParseAccessListResult(t *testing.T) {
+	result, err := ethtx.ParseAccessListResult(`{"accessList":` + accessListJSON + `,"gasUsed":"0x7c8d"}`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(result.AccessList))
+	assert.Equal(t, "0x7c8d", result.GasUsed)
+
+	_, err = ethtx.ParseAccessListResult(`{"accessList":[],"gasUsed":"0x0","error":"execution reverted"}`)
+
+	assert.Error(t, err)
+}
+
+func TestAccessListTx(t *testing.T) {
+	key, err := keystore.KeyFromPrivateKey(bytes.Repeat([]byte{0x46}, 32))
+
+	assert.NoError(t, err)
+
+	to, _ := ethtx.ParseAddress("0x3535353535353535353535353535353535353535")
+
+	tx := ethtx.NewAccessListTx(big.NewInt(1), 0, to, big.NewInt(1000), big.NewInt(1), big.NewInt(21000), nil, nil)
+
+	hash, err := tx.SigningHash()
+
+	assert.NoError(t, err)
+
+	// signing payload is 0x01 || rlp of the eight fields with an empty access list
+	payload, _ := hex.DecodeString("01e00180018252089435353535353535353535353535353535353535358203e880c0")
+
+	assert.Equal(t, ethtx.Keccak256(payload), hash)
+
+	assert.NoError(t, tx.Sign(key.PrivateKey))
+	assert.True(t, tx.V.Int64() == 0 || tx.V.Int64() == 1)
+
+	data, err := tx.Encode()
+
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x01), data[0])
+
+	var fields []rlp.RawValue
+
+	assert.NoError(t, rlp.DecodeBytes(data[1:], &fields))
+	assert.Equal(t, 11, len(fields))
+
+	address, err := ethtx.RecoverAddress(hash, byte(tx.V.Int64()), tx.R, tx.S)
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.Address, address)
+
+	list, _ := ethtx.ParseAccessList(accessListJSON)
+
+	tx.AccessList = list
+
+	data, err = tx.Encode()
+
+	assert.NoError(t, err)
+	assert.Contains(t, hex.EncodeToString(data), "94de0b295669a9fd93d5f28d9ec85e40f4cb697baef842")
+}
@@ -0,0 +1,54 @@
+package ethtxtest
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAddress(t *testing.T) {
+	sender, _ := ethtx.ParseAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+
+	expected := []string{
+		"cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d",
+		"343c43a37d37dff08ae8c4a11544c718abb4fcf8",
+		"f778b86fa74e846c4f0a1fbd1335fe81c00a0c91",
+		"fffd933a0bc612844eaf0c6fe3e5b8e9b6c1d19c",
+	}
+
+	for nonce, address := range expected {
+		result, err := ethtx.CreateAddress(*sender, uint64(nonce))
+
+		assert.NoError(t, err)
+		assert.Equal(t, address, hex.EncodeToString(result[:]))
+	}
+}
+
+func TestCreate2Address(t *testing.T) {
+	// eip-1014 examples
+	factory, _ := ethtx.ParseAddress("0x0000000000000000000000000000000000000000")
+
+	var salt [32]byte
+
+	result := ethtx.Create2Address(*factory, salt, []byte{0x00})
+
+	assert.Equal(t, "4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38", hex.EncodeToString(result[:]))
+
+	factory, _ = ethtx.ParseAddress("0xdeadbeef00000000000000000000000000000000")
+
+	result = ethtx.Create2Address(*factory, salt, []byte{0x00})
+
+	assert.Equal(t, "b928f69bb1d91cd65274e3c79d8986362984fda3", hex.EncodeToString(result[:]))
+
+	factory, _ = ethtx.ParseAddress("0x00000000000000000000000000000000deadbeef")
+
+	copy(salt[28:], []byte{0xca, 0xfe, 0xba, 0xbe})
+
+	code, _ := hex.DecodeString("deadbeef")
+
+	result = ethtx.Create2Address(*factory, salt, code)
+
+	assert.Equal(t, "60f3f640a8508fc6a86d45df051962668e1e8ac7", hex.EncodeToString(result[:]))
+}
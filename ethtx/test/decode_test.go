@@ -0,0 +1,200 @@
+package ethtxtest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/inwecrypto/ethgo/erc20"
+	"github.com/inwecrypto/ethgo/erc721"
+	"github.com/inwecrypto/ethgo/keystore"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeLegacyTx(t *testing.T) {
+	// eip-155 example
+	raw, _ := hex.DecodeString("f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83")
+
+	tx, err := ethtx.DecodeTransaction(raw)
+
+	assert.NoError(t, err)
+	assert.Equal(t, byte(ethtx.LegacyTxType), tx.Type)
+	assert.Equal(t, int64(1), tx.ChainID.Int64())
+	assert.Equal(t, uint64(9), tx.Nonce)
+	assert.Equal(t, "1000000000000000000", tx.Value.String())
+	assert.True(t, tx.Signed())
+	assert.Equal(t, "33469b22e9f636356c4160a87eb19df52b7412e8eac32a4a55ffe88ea8350788", hex.EncodeToString(tx.Hash()))
+
+	sender, err := tx.Sender()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F", sender)
+
+	// unsigned eip-155 signing form
+	raw, _ = hex.DecodeString("ec098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080018080")
+
+	tx, err = ethtx.DecodeTransaction(raw)
+
+	assert.NoError(t, err)
+	assert.False(t, tx.Signed())
+	assert.Equal(t, int64(1), tx.ChainID.Int64())
+
+	hash, err := tx.SigningHash()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", hex.EncodeToString(hash))
+
+	_, err = tx.Sender()
+
+	assert.Equal(t, ethtx.ErrUnsigned, err)
+}
+
+func TestDecodeTypedTx(t *testing.T) {
+	key, err := keystore.KeyFromPrivateKey(bytes.Repeat([]byte{0x46}, 32))
+
+	assert.NoError(t, err)
+
+	to, _ := ethtx.ParseAddress("0x3535353535353535353535353535353535353535")
+
+	list, _ := ethtx.ParseAccessList(accessListJSON)
+
+	dynamicFeeTx := ethtx.NewDynamicFeeTx(big.NewInt(5), 3, to, big.NewInt(1000), big.NewInt(1), big.NewInt(2), big.NewInt(21000), []byte{1, 2}, list)
+
+	assert.NoError(t, dynamicFeeTx.Sign(key.PrivateKey))
+
+	raw, err := dynamicFeeTx.Encode()
+
+	assert.NoError(t, err)
+
+	tx, err := ethtx.DecodeTransaction(raw)
+
+	assert.NoError(t, err)
+	assert.Equal(t, byte(ethtx.DynamicFeeTxType), tx.Type)
+	assert.Equal(t, int64(5), tx.ChainID.Int64())
+	assert.Equal(t, uint64(3), tx.Nonce)
+	assert.Equal(t, int64(1), tx.GasTipCap.Int64())
+	assert.Equal(t, int64(2), tx.GasFeeCap.Int64())
+	assert.Nil(t, tx.GasPrice)
+	assert.Equal(t, *to, *tx.To)
+	assert.Equal(t, []byte{1, 2}, tx.Data)
+	assert.Equal(t, list, tx.AccessList)
+
+	sender, err := tx.Sender()
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.Address, sender)
+
+	accessListTx := ethtx.NewAccessListTx(big.NewInt(1), 0, nil, nil, big.NewInt(1), big.NewInt(53000), []byte{0x60}, nil)
+
+	assert.NoError(t, accessListTx.Sign(key.PrivateKey))
+
+	raw, err = accessListTx.Encode()
+
+	assert.NoError(t, err)
+
+	tx, err = ethtx.DecodeTransaction(raw)
+
+	assert.NoError(t, err)
+	assert.Equal(t, byte(ethtx.AccessListTxType), tx.Type)
+	assert.Nil(t, tx.To)
+	assert.Equal(t, int64(1), tx.GasPrice.Int64())
+
+	sender, err = tx.Sender()
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.Address, sender)
+
+	_, err = ethtx.DecodeTransaction([]byte{0x03, 0xc0})
+
+	assert.Equal(t, ethtx.ErrTxType, err)
+
+	_, err = ethtx.DecodeTransaction([]byte{0x02, 0xc0})
+
+	assert.Equal(t, ethtx.ErrTxFields, err)
+}
+
+func TestDecodeInvalidSignature(t *testing.T) {
+	key, err := keystore.KeyFromPrivateKey(bytes.Repeat([]byte{0x46}, 32))
+
+	assert.NoError(t, err)
+
+	to, _ := ethtx.ParseAddress("0x3535353535353535353535353535353535353535")
+
+	oversized := new(big.Int).Lsh(big.NewInt(1), 264)
+
+	legacyTx := ethtx.NewLegacyTx(big.NewInt(1), 9, to, big.NewInt(1), big.NewInt(1), big.NewInt(21000), nil)
+
+	assert.NoError(t, legacyTx.Sign(key.PrivateKey))
+
+	for _, r := range []*big.Int{oversized, big.NewInt(0)} {
+		legacyTx.R = r
+
+		raw, err := legacyTx.Encode()
+
+		assert.NoError(t, err)
+
+		_, err = ethtx.DecodeTransaction(raw)
+
+		assert.Equal(t, ethtx.ErrSignatureValues, err)
+	}
+
+	dynamicFeeTx := ethtx.NewDynamicFeeTx(big.NewInt(1), 0, to, nil, big.NewInt(1), big.NewInt(2), big.NewInt(21000), nil, nil)
+
+	assert.NoError(t, dynamicFeeTx.Sign(key.PrivateKey))
+
+	for _, s := range []*big.Int{oversized, big.NewInt(0)} {
+		dynamicFeeTx.S = s
+
+		raw, err := dynamicFeeTx.Encode()
+
+		assert.NoError(t, err)
+
+		_, err = ethtx.DecodeTransaction(raw)
+
+		assert.Equal(t, ethtx.ErrSignatureValues, err)
+	}
+
+	_, err = ethtx.RecoverAddress(make([]byte, 32), 0, oversized, big.NewInt(1))
+
+	assert.Equal(t, ethtx.ErrSignatureValues, err)
+}
+
+func TestDecodeCallData(t *testing.T) {
+	data, _ := erc20.Transfer("0x3535353535353535353535353535353535353535", "0x3e8")
+
+	call, err := ethtx.DecodeCallData(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer", call.Method)
+	assert.Equal(t, "to", call.Arguments[0].Name)
+	assert.Equal(t, "0x3535353535353535353535353535353535353535", call.Arguments[0].Value)
+	assert.Equal(t, "1000", call.Arguments[1].Value)
+
+	data, _ = erc721.TransferLand("0x3535353535353535353535353535353535353535", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffb", "0x10")
+
+	call, err = ethtx.DecodeCallData(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "transferLand", call.Method)
+	assert.Equal(t, "-5", call.Arguments[0].Value)
+	assert.Equal(t, "16", call.Arguments[1].Value)
+
+	data, _ = erc721.OpenMany("0x1", []string{"0x3535353535353535353535353535353535353535", "0x0000000000000000000000000000000000000001"}, "0x2", true)
+
+	call, err = ethtx.DecodeCallData(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "openMany", call.Method)
+	assert.Equal(t, []string{"0x3535353535353535353535353535353535353535", "0x0000000000000000000000000000000000000001"}, call.Arguments[1].Value)
+	assert.Equal(t, true, call.Arguments[3].Value)
+
+	_, err = ethtx.DecodeCallData([]byte{1, 2, 3, 4})
+
+	assert.Equal(t, ethtx.ErrUnknownMethod, err)
+
+	_, err = ethtx.DecodeCallData(data[:40])
+
+	assert.Equal(t, ethtx.ErrCallData, err)
+}
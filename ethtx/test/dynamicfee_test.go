@@ -0,0 +1,76 @@
+package ethtxtest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/inwecrypto/ethgo/keystore"
+	"github.com/inwecrypto/ethgo/rlp"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamicFeeTx(t *testing.T) {
+	key, err := keystore.KeyFromPrivateKey(bytes.Repeat([]byte{0x46}, 32))
+
+	assert.NoError(t, err)
+
+	to, _ := ethtx.ParseAddress("0x3535353535353535353535353535353535353535")
+
+	tx := ethtx.NewDynamicFeeTx(big.NewInt(1), 9, to, big.NewInt(1000), big.NewInt(2000000000), big.NewInt(30000000000), big.NewInt(21000), nil, nil)
+
+	hash, err := tx.SigningHash()
+
+	assert.NoError(t, err)
+
+	// signing payload is 0x02 || rlp of the nine fields with an empty access list
+	payload, _ := hex.DecodeString("02ea010984773594008506fc23ac008252089435353535353535353535353535353535353535358203e880c0")
+
+	assert.Equal(t, ethtx.Keccak256(payload), hash)
+
+	assert.NoError(t, tx.Sign(key.PrivateKey))
+	assert.True(t, tx.V.Int64() == 0 || tx.V.Int64() == 1)
+
+	data, err := tx.Encode()
+
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x02), data[0])
+
+	var fields []rlp.RawValue
+
+	assert.NoError(t, rlp.DecodeBytes(data[1:], &fields))
+	assert.Equal(t, 12, len(fields))
+
+	address, err := ethtx.RecoverAddress(hash, byte(tx.V.Int64()), tx.R, tx.S)
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.Address, address)
+}
+
+func TestDynamicFeeTxAccessList(t *testing.T) {
+	to, _ := ethtx.ParseAddress("0x3535353535353535353535353535353535353535")
+
+	var tuple ethtx.AccessTuple
+
+	tuple.Address = *to
+	tuple.StorageKeys = [][32]byte{{}, {31: 1}}
+
+	tx := ethtx.NewDynamicFeeTx(big.NewInt(1), 0, to, nil, big.NewInt(1), big.NewInt(1), big.NewInt(21000), nil, ethtx.AccessList{tuple})
+
+	tx.ChainID = nil
+
+	_, err := tx.SigningHash()
+
+	assert.Equal(t, ethtx.ErrChainID, err)
+
+	tx.ChainID = big.NewInt(1)
+
+	data, err := tx.Encode()
+
+	assert.NoError(t, err)
+
+	// access list [[address, [key0, key1]]] is encoded before signature fields
+	assert.Contains(t, hex.EncodeToString(data), "f85bf859943535353535353535353535353535353535353535f842a00000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000001")
+}
@@ -0,0 +1,66 @@
+package ethtxtest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/inwecrypto/ethgo/keystore"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/stretchr/testify/assert"
+)
+
+// eip-155 example transaction
+func TestLegacyTxEIP155(t *testing.T) {
+	key, err := keystore.KeyFromPrivateKey(bytes.Repeat([]byte{0x46}, 32))
+
+	assert.NoError(t, err)
+
+	to, err := ethtx.ParseAddress("0x3535353535353535353535353535353535353535")
+
+	assert.NoError(t, err)
+
+	value, _ := new(big.Int).SetString("1000000000000000000", 10)
+
+	tx := ethtx.NewLegacyTx(big.NewInt(1), 9, to, value, big.NewInt(20000000000), big.NewInt(21000), nil)
+
+	hash, err := tx.SigningHash()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", hex.EncodeToString(hash))
+
+	assert.NoError(t, tx.Sign(key.PrivateKey))
+	assert.Equal(t, int64(37), tx.V.Int64())
+
+	data, err := tx.Encode()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", hex.EncodeToString(data))
+}
+
+func TestLegacyTxChainID(t *testing.T) {
+	key, err := keystore.KeyFromPrivateKey(bytes.Repeat([]byte{0x46}, 32))
+
+	assert.NoError(t, err)
+
+	tx := ethtx.NewLegacyTx(nil, 0, nil, nil, big.NewInt(1), big.NewInt(21000), nil)
+
+	assert.Equal(t, ethtx.ErrChainID, tx.Sign(key.PrivateKey))
+
+	tx.ChainID = big.NewInt(61)
+
+	assert.NoError(t, tx.Sign(key.PrivateKey))
+	assert.True(t, tx.V.Int64() == 157 || tx.V.Int64() == 158)
+}
+
+func TestParseAddress(t *testing.T) {
+	address, err := ethtx.ParseAddress("")
+
+	assert.NoError(t, err)
+	assert.Nil(t, address)
+
+	_, err = ethtx.ParseAddress("0x1234")
+
+	assert.Error(t, err)
+}
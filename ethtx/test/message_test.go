@@ -0,0 +1,49 @@
+package ethtxtest
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/inwecrypto/ethgo/keystore"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonalMessage(t *testing.T) {
+	privateKey, _ := hex.DecodeString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+
+	key, err := keystore.KeyFromPrivateKey(privateKey)
+
+	assert.NoError(t, err)
+
+	hash := ethtx.PersonalMessageHash([]byte("Some data"))
+
+	assert.Equal(t, "1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655", hex.EncodeToString(hash))
+
+	signature, err := ethtx.SignHash(hash, key.PrivateKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c", hex.EncodeToString(signature))
+
+	signer, err := ethtx.RecoverSigner(hash, signature)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", signer)
+
+	signature[64] = 1
+
+	signer, err = ethtx.RecoverSigner(hash, signature)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", signer)
+
+	signature[64] = 29
+
+	_, err = ethtx.RecoverSigner(hash, signature)
+
+	assert.Equal(t, ethtx.ErrSignature, err)
+
+	_, err = ethtx.RecoverSigner(hash, signature[:64])
+
+	assert.Equal(t, ethtx.ErrSignatureLength, err)
+}
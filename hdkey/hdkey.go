@@ -0,0 +1,273 @@
+package hdkey
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/inwecrypto/gosecp256k1"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// HardenedKeyStart first hardened child index
+const HardenedKeyStart uint32 = 0x80000000
+
+// Errors
+var (
+	ErrUnsupportedCurve = errors.New("hdkey: unsupported curve")
+	ErrInvalidSeed      = errors.New("hdkey: seed length must be between 128 and 512 bits")
+	ErrInvalidKey       = errors.New("hdkey: derived key is invalid")
+	ErrInvalidPath      = errors.New("hdkey: invalid derivation path")
+)
+
+// version bytes of bip32 mainnet extended private key
+var xprvVersion = []byte{0x04, 0x88, 0xad, 0xe4}
+
+// Key bip32 extended private key
+type Key struct {
+	Curve             elliptic.Curve // key curve
+	PrivateKey        []byte         // 32 bytes private key
+	ChainCode         []byte         // 32 bytes chain code
+	Depth             byte           // derivation depth, master is 0
+	ParentFingerprint []byte         // first 4 bytes of parent's hash160
+	ChildNumber       uint32         // child index of this key
+}
+
+// hmacKeyOf get master key hmac key of curve, see slip-0010
+func hmacKeyOf(curve elliptic.Curve) ([]byte, error) {
+	if curve == secp256k1.S256() {
+		return []byte("Bitcoin seed"), nil
+	}
+
+	if curve == elliptic.P256() {
+		return []byte("Nist256p1 seed"), nil
+	}
+
+	return nil, ErrUnsupportedCurve
+}
+
+// NewMasterKey create master key from bip39 seed, curve can be secp256k1 (bip32)
+// or nist p-256 (slip-0010).
+//
+// Invalid intermediate keys are handled as slip-0010 specified: hmac is
+// recomputed until a valid key is found
+func NewMasterKey(seed []byte, curve elliptic.Curve) (*Key, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrInvalidSeed
+	}
+
+	hmacKey, err := hmacKeyOf(curve)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	for !isValidPrivateKey(sum[:32], curve) {
+		mac.Reset()
+		mac.Write(sum)
+		sum = mac.Sum(nil)
+	}
+
+	return &Key{
+		Curve:             curve,
+		PrivateKey:        sum[:32],
+		ChainCode:         sum[32:],
+		ParentFingerprint: []byte{0, 0, 0, 0},
+	}, nil
+}
+
+// Child derive child key with index, index >= HardenedKeyStart means hardened child
+func (key *Key) Child(index uint32) (*Key, error) {
+	var data []byte
+
+	if index >= HardenedKeyStart {
+		data = append([]byte{0x00}, key.PrivateKey...)
+	} else {
+		data = key.PublicKey()
+	}
+
+	data = append(data, uint32Bytes(index)...)
+
+	n := key.Curve.Params().N
+
+	for {
+		mac := hmac.New(sha512.New, key.ChainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+
+		il := new(big.Int).SetBytes(sum[:32])
+
+		if il.Cmp(n) < 0 {
+			childKey := il.Add(il, new(big.Int).SetBytes(key.PrivateKey))
+			childKey.Mod(childKey, n)
+
+			if childKey.Sign() != 0 {
+				return &Key{
+					Curve:             key.Curve,
+					PrivateKey:        paddedBytes(childKey, 32),
+					ChainCode:         sum[32:],
+					Depth:             key.Depth + 1,
+					ParentFingerprint: key.Fingerprint(),
+					ChildNumber:       index,
+				}, nil
+			}
+		}
+
+		// slip-0010: retry with data = 0x01 || IR || ser32(i)
+		data = append([]byte{0x01}, sum[32:]...)
+		data = append(data, uint32Bytes(index)...)
+	}
+}
+
+// DerivePath derive descendant key with path like m/44'/60'/0'/0/0
+func (key *Key) DerivePath(path string) (*Key, error) {
+	indexes, err := ParsePath(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := key
+
+	for _, index := range indexes {
+		current, err = current.Child(index)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return current, nil
+}
+
+// PublicKey get compressed public key bytes
+func (key *Key) PublicKey() []byte {
+	x, y := key.Curve.ScalarBaseMult(key.PrivateKey)
+
+	prefix := byte(0x02)
+
+	if y.Bit(0) == 1 {
+		prefix = 0x03
+	}
+
+	return append([]byte{prefix}, paddedBytes(x, 32)...)
+}
+
+// Fingerprint get key's fingerprint, the first 4 bytes of hash160(publickey)
+func (key *Key) Fingerprint() []byte {
+	sha := sha256.Sum256(key.PublicKey())
+
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+
+	return hasher.Sum(nil)[:4]
+}
+
+// ECDSA get key as ecdsa private key
+func (key *Key) ECDSA() *ecdsa.PrivateKey {
+	priv := new(ecdsa.PrivateKey)
+	priv.PublicKey.Curve = key.Curve
+	priv.D = new(big.Int).SetBytes(key.PrivateKey)
+	priv.PublicKey.X, priv.PublicKey.Y = key.Curve.ScalarBaseMult(key.PrivateKey)
+
+	return priv
+}
+
+// String get bip32 base58 serialized extended private key (xprv...)
+func (key *Key) String() string {
+	var buff bytes.Buffer
+
+	buff.Write(xprvVersion)
+	buff.WriteByte(key.Depth)
+	buff.Write(key.ParentFingerprint)
+	buff.Write(uint32Bytes(key.ChildNumber))
+	buff.Write(key.ChainCode)
+	buff.WriteByte(0x00)
+	buff.Write(key.PrivateKey)
+
+	hash1 := sha256.Sum256(buff.Bytes())
+	hash2 := sha256.Sum256(hash1[:])
+
+	buff.Write(hash2[:4])
+
+	return base58.Encode(buff.Bytes())
+}
+
+// ParsePath parse derivation path like m/44'/60'/0'/0/0 into child indexes,
+// hardened index can be marked with ', h or H
+func ParsePath(path string) ([]uint32, error) {
+	path = strings.TrimSpace(path)
+
+	parts := strings.Split(path, "/")
+
+	if len(parts) == 0 || (parts[0] != "m" && parts[0] != "M") {
+		return nil, fmt.Errorf("%s: %s", ErrInvalidPath, path)
+	}
+
+	indexes := make([]uint32, 0, len(parts)-1)
+
+	for _, part := range parts[1:] {
+		hardened := false
+
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H") {
+			hardened = true
+			part = part[:len(part)-1]
+		}
+
+		index, err := strconv.ParseUint(part, 10, 32)
+
+		if err != nil || uint32(index) >= HardenedKeyStart {
+			return nil, fmt.Errorf("%s: %s", ErrInvalidPath, path)
+		}
+
+		if hardened {
+			index += uint64(HardenedKeyStart)
+		}
+
+		indexes = append(indexes, uint32(index))
+	}
+
+	return indexes, nil
+}
+
+func isValidPrivateKey(key []byte, curve elliptic.Curve) bool {
+	k := new(big.Int).SetBytes(key)
+
+	return k.Sign() > 0 && k.Cmp(curve.Params().N) < 0
+}
+
+func uint32Bytes(i uint32) []byte {
+	buff := make([]byte, 4)
+
+	binary.BigEndian.PutUint32(buff, i)
+
+	return buff
+}
+
+func paddedBytes(i *big.Int, n int) []byte {
+	bytes := i.Bytes()
+
+	if len(bytes) >= n {
+		return bytes
+	}
+
+	padded := make([]byte, n)
+
+	copy(padded[n-len(bytes):], bytes)
+
+	return padded
+}
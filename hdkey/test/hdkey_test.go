@@ -0,0 +1,151 @@
+package hdkeytest
+
+import (
+	"crypto/elliptic"
+	"encoding/hex"
+	"testing"
+
+	"github.com/inwecrypto/gosecp256k1"
+	"github.com/inwecrypto/mobilesdk/hdkey"
+	"github.com/stretchr/testify/assert"
+)
+
+type derivationVector struct {
+	path string
+	xprv string
+}
+
+func testVectors(t *testing.T, seed string, vectors []derivationVector) {
+	bytesOfSeed, err := hex.DecodeString(seed)
+
+	assert.NoError(t, err)
+
+	master, err := hdkey.NewMasterKey(bytesOfSeed, secp256k1.S256())
+
+	assert.NoError(t, err)
+
+	for _, vector := range vectors {
+		key, err := master.DerivePath(vector.path)
+
+		assert.NoError(t, err)
+
+		assert.Equal(t, vector.xprv, key.String(), vector.path)
+	}
+}
+
+func TestBIP32Vector1(t *testing.T) {
+	testVectors(t, "000102030405060708090a0b0c0d0e0f", []derivationVector{
+		{"m", "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"},
+		{"m/0'", "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+		{"m/0'/1", "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+		{"m/0'/1/2'", "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM"},
+		{"m/0'/1/2'/2", "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334"},
+		{"m/0'/1/2'/2/1000000000", "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76"},
+	})
+}
+
+func TestBIP32Vector2(t *testing.T) {
+	testVectors(t, "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542", []derivationVector{
+		{"m", "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U"},
+		{"m/0", "xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt"},
+		{"m/0/2147483647h", "xprv9wSp6B7kry3Vj9m1zSnLvN3xH8RdsPP1Mh7fAaR7aRLcQMKTR2vidYEeEg2mUCTAwCd6vnxVrcjfy2kRgVsFawNzmjuHc2YmYRmagcEPdU9"},
+		{"m/0/2147483647h/1", "xprv9zFnWC6h2cLgpmSA46vutJzBcfJ8yaJGg8cX1e5StJh45BBciYTRXSd25UEPVuesF9yog62tGAQtHjXajPPdbRCHuWS6T8XA2ECKADdw4Ef"},
+		{"m/0/2147483647h/1/2147483646h", "xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc"},
+		{"m/0/2147483647h/1/2147483646h/2", "xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j"},
+	})
+}
+
+func TestBIP32Vector3(t *testing.T) {
+	testVectors(t, "4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be", []derivationVector{
+		{"m", "xprv9s21ZrQH143K25QhxbucbDDuQ4naNntJRi4KUfWT7xo4EKsHt2QJDu7KXp1A3u7Bi1j8ph3EGsZ9Xvz9dGuVrtHHs7pXeTzjuxBrCmmhgC6"},
+		{"m/0H", "xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L"},
+	})
+}
+
+func TestParsePath(t *testing.T) {
+	indexes, err := hdkey.ParsePath("m/44'/60'/0'/0/1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{hdkey.HardenedKeyStart + 44, hdkey.HardenedKeyStart + 60, hdkey.HardenedKeyStart, 0, 1}, indexes)
+
+	for _, path := range []string{"", "44'/60'", "m/-1", "m/2147483648", "m/a'", "m//0"} {
+		_, err := hdkey.ParsePath(path)
+
+		assert.Error(t, err, path)
+	}
+}
+
+type slip10Vector struct {
+	path       string
+	chainCode  string
+	privateKey string
+	publicKey  string
+}
+
+func testSLIP10Vectors(t *testing.T, seed string, vectors []slip10Vector) {
+	bytesOfSeed, err := hex.DecodeString(seed)
+
+	assert.NoError(t, err)
+
+	master, err := hdkey.NewMasterKey(bytesOfSeed, elliptic.P256())
+
+	assert.NoError(t, err)
+
+	for _, vector := range vectors {
+		key, err := master.DerivePath(vector.path)
+
+		assert.NoError(t, err)
+
+		assert.Equal(t, vector.chainCode, hex.EncodeToString(key.ChainCode), vector.path)
+		assert.Equal(t, vector.privateKey, hex.EncodeToString(key.PrivateKey), vector.path)
+
+		if vector.publicKey != "" {
+			assert.Equal(t, vector.publicKey, hex.EncodeToString(key.PublicKey()), vector.path)
+		}
+	}
+}
+
+func TestSLIP10NIST256P1Vector1(t *testing.T) {
+	testSLIP10Vectors(t, "000102030405060708090a0b0c0d0e0f", []slip10Vector{
+		{
+			"m",
+			"beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea",
+			"612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2",
+			"0266874dc6ade47b3ecd096745ca09bcd29638dd52c2c12117b11ed3e458cfa9e8",
+		},
+		{
+			"m/0'",
+			"3460cea53e6a6bb5fb391eeef3237ffd8724bf0a40e94943c98b83825342ee11",
+			"6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c",
+			"0384610f5ecffe8fda089363a41f56a5c7ffc1d81b59a612d0d649b2d22355590c",
+		},
+	})
+}
+
+func TestSLIP10NIST256P1DerivationRetry(t *testing.T) {
+	testSLIP10Vectors(t, "000102030405060708090a0b0c0d0e0f", []slip10Vector{
+		{
+			"m/28578'",
+			"e94c8ebe30c2250a14713212f6449b20f3329105ea15b652ca5bdfc68f6c65c2",
+			"06f0db126f023755d0b8d86d4591718a5210dd8d024e3e14b6159d63f53aa669",
+			"",
+		},
+		{
+			"m/28578'/33941",
+			"9e87fe95031f14736774cd82f25fd885065cb7c358c1edf813c72af535e83071",
+			"092154eed4af83e078ff9b84322015aefe5769e31270f62c3f66c33888335f3a",
+			"",
+		},
+	})
+}
+
+func TestSLIP10NIST256P1SeedRetry(t *testing.T) {
+	testSLIP10Vectors(t, "a7305bc8df8d0951f0cb224c0e95d7707cbdf2c6ce7e8d481fec69c7ff5e9446", []slip10Vector{
+		{
+			"m",
+			"7762f9729fed06121fd13f326884c82f59aa95c57ac492ce8c9654e60efd130c",
+			"3b8c18469a4634517d6d0b65448f8e6c62091b45540a1743c5846be55d47d88f",
+			"",
+		},
+	})
+}
@@ -0,0 +1,360 @@
+package hdmobile
+
+import (
+	"crypto/elliptic"
+	"encoding/json"
+	"fmt"
+
+	"github.com/inwecrypto/gosecp256k1"
+	"github.com/inwecrypto/mobilesdk/ethmobile"
+	"github.com/inwecrypto/mobilesdk/hdkey"
+	"github.com/inwecrypto/mobilesdk/keystoremobile"
+	"github.com/inwecrypto/mobilesdk/neomobile"
+	"github.com/inwecrypto/mobilesdk/seedphrase"
+	"github.com/inwecrypto/mobilesdk/web3keystore"
+)
+
+// chain names
+const (
+	ChainETH = "eth"
+	ChainNEO = "neo"
+)
+
+// keyStoreType type tag of hd wallet keystore
+const keyStoreType = "hdwallet"
+
+// Account derived hd wallet account
+type Account struct {
+	Chain   string `json:"chain"`
+	Index   int    `json:"index"`
+	Path    string `json:"path"`
+	Address string `json:"address"`
+}
+
+// HDWallet multi-chain hd wallet, all eth and neo accounts are derived from one mnemonic
+type HDWallet struct {
+	mnemonic      string
+	lang          string
+	hasPassphrase bool
+	ethMaster     *hdkey.Key
+	neoMaster     *hdkey.Key
+	ethAccounts   []*Account
+	neoAccounts   []*Account
+}
+
+// GenerateMnemonic create random mnemonic with words count 12, 15, 18, 21 or 24
+func GenerateMnemonic(words int, lang string) (string, error) {
+	return seedphrase.Generate(words, lang, nil)
+}
+
+// GenerateMnemonicWithEntropy create random mnemonic with extra entropy supplied by
+// host app such as dice rolls, which is mixed with system random bytes
+func GenerateMnemonicWithEntropy(words int, lang string, extraEntropy []byte) (string, error) {
+	return seedphrase.Generate(words, lang, extraEntropy)
+}
+
+// TranslateMnemonic convert mnemonic to another language keeping entropy and checksum,
+// empty fromLang means detect language automatically. The translated mnemonic is a
+// different bip39 seed and restores different accounts
+func TranslateMnemonic(mnemonic string, fromLang string, toLang string) (string, error) {
+	return seedphrase.Translate(mnemonic, fromLang, toLang)
+}
+
+// CompleteMnemonicWord get json array of words start with prefix, limit <= 0 means no limit
+func CompleteMnemonicWord(prefix string, lang string, limit int) (string, error) {
+	dic, err := seedphrase.Dict(lang)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(seedphrase.Complete(prefix, dic, limit))
+
+	return string(data), err
+}
+
+// CheckMnemonic get json report of mnemonic: validity, positions of unknown words with
+// close matches and single word substitutions that pass checksum, empty lang means
+// detect language automatically
+func CheckMnemonic(mnemonic string, lang string, limit int) (string, error) {
+	if lang == "" {
+		guessed, err := seedphrase.GuessLanguage(mnemonic)
+
+		if err != nil {
+			return "", err
+		}
+
+		lang = guessed
+	}
+
+	dic, err := seedphrase.Dict(lang)
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(seedphrase.Check(mnemonic, dic, limit))
+
+	return string(data), err
+}
+
+// DetectMnemonicLanguage detect mnemonic language, result can be used as lang parameter
+func DetectMnemonicLanguage(mnemonic string) (string, error) {
+	return seedphrase.DetectLanguage(mnemonic)
+}
+
+// NewHDWallet create hd wallet from mnemonic and bip39 passphrase, passphrase can be empty
+// and empty lang means detect language automatically
+func NewHDWallet(mnemonic string, lang string, passphrase string) (*HDWallet, error) {
+	lang, dic, err := seedphrase.ResolveDict(mnemonic, lang)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := seedphrase.NewSeed(mnemonic, passphrase, dic)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ethMaster, err := hdkey.NewMasterKey(seed, secp256k1.S256())
+
+	if err != nil {
+		return nil, err
+	}
+
+	neoMaster, err := hdkey.NewMasterKey(seed, elliptic.P256())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &HDWallet{
+		mnemonic:      seedphrase.Normalize(mnemonic),
+		lang:          lang,
+		hasPassphrase: passphrase != "",
+		ethMaster:     ethMaster,
+		neoMaster:     neoMaster,
+	}, nil
+}
+
+// Mnemonic get wallet mnemonic
+func (wallet *HDWallet) Mnemonic() string {
+	return wallet.mnemonic
+}
+
+// Lang get mnemonic language
+func (wallet *HDWallet) Lang() string {
+	return wallet.lang
+}
+
+// HasPassphrase check if wallet seed is protected by bip39 passphrase
+func (wallet *HDWallet) HasPassphrase() bool {
+	return wallet.hasPassphrase
+}
+
+// ETHAccount get eth signer of account index, path is m/44'/60'/0'/0/index
+func (wallet *HDWallet) ETHAccount(index int) (*ethmobile.Wallet, error) {
+	path := fmt.Sprintf(ethmobile.ETHPath, index)
+
+	key, err := derive(wallet.ethMaster, index, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ethmobile.FromHDKey(key, wallet.mnemonic, wallet.lang, wallet.hasPassphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wallet.ethAccounts = addAccount(wallet.ethAccounts, &Account{
+		Chain:   ChainETH,
+		Index:   index,
+		Path:    path,
+		Address: signer.Address(),
+	})
+
+	return signer, nil
+}
+
+// NEOAccount get neo signer of account index, path is m/44'/888'/0'/0/index
+func (wallet *HDWallet) NEOAccount(index int) (*neomobile.Wallet, error) {
+	path := fmt.Sprintf(neomobile.NEOPath, index)
+
+	key, err := derive(wallet.neoMaster, index, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := neomobile.FromHDKey(key, wallet.mnemonic, wallet.lang, wallet.hasPassphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wallet.neoAccounts = addAccount(wallet.neoAccounts, &Account{
+		Chain:   ChainNEO,
+		Index:   index,
+		Path:    path,
+		Address: signer.Address(),
+	})
+
+	return signer, nil
+}
+
+// AddETHAccount derive next eth account
+func (wallet *HDWallet) AddETHAccount() (*ethmobile.Wallet, error) {
+	return wallet.ETHAccount(nextIndex(wallet.ethAccounts))
+}
+
+// AddNEOAccount derive next neo account
+func (wallet *HDWallet) AddNEOAccount() (*neomobile.Wallet, error) {
+	return wallet.NEOAccount(nextIndex(wallet.neoAccounts))
+}
+
+// ETHAccountCount get derived eth accounts count
+func (wallet *HDWallet) ETHAccountCount() int {
+	return len(wallet.ethAccounts)
+}
+
+// NEOAccountCount get derived neo accounts count
+func (wallet *HDWallet) NEOAccountCount() int {
+	return len(wallet.neoAccounts)
+}
+
+// Accounts get all derived accounts as json array of {chain, index, path, address}
+func (wallet *HDWallet) Accounts() (string, error) {
+	accounts := append(append([]*Account{}, wallet.ethAccounts...), wallet.neoAccounts...)
+
+	data, err := json.Marshal(accounts)
+
+	return string(data), err
+}
+
+// ExportKeyStore encrypt mnemonic, language, passphrase flag and derived accounts as
+// keystore style json, nil options means light scrypt. The bip39 passphrase itself is
+// not stored and must be supplied again to ImportHDWallet
+func (wallet *HDWallet) ExportKeyStore(password string, options *keystoremobile.KeyStoreOptions) (string, error) {
+	data, err := json.Marshal(&hdWalletJSON{
+		Mnemonic:   wallet.mnemonic,
+		Lang:       wallet.lang,
+		Passphrase: wallet.hasPassphrase,
+		Accounts:   append(append([]*Account{}, wallet.ethAccounts...), wallet.neoAccounts...),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	defer zeroBytes(data)
+
+	keystore, err := web3keystore.EncryptSecret(data, keyStoreType, password, options.Web3Options())
+
+	return string(keystore), err
+}
+
+// ImportHDWallet restore hd wallet and its accounts from ExportKeyStore json, passphrase
+// is the bip39 passphrase and is checked against the exported account addresses
+func ImportHDWallet(keystore string, password string, passphrase string) (*HDWallet, error) {
+	data, err := web3keystore.DecryptSecret([]byte(keystore), keyStoreType, password)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer zeroBytes(data)
+
+	var exported hdWalletJSON
+
+	if err := json.Unmarshal(data, &exported); err != nil {
+		return nil, err
+	}
+
+	if exported.Passphrase != (passphrase != "") {
+		return nil, fmt.Errorf("bip39 passphrase mismatch")
+	}
+
+	wallet, err := NewHDWallet(exported.Mnemonic, exported.Lang, passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range exported.Accounts {
+		var address string
+
+		switch account.Chain {
+		case ChainETH:
+			signer, err := wallet.ETHAccount(account.Index)
+
+			if err != nil {
+				return nil, err
+			}
+
+			address = signer.Address()
+		case ChainNEO:
+			signer, err := wallet.NEOAccount(account.Index)
+
+			if err != nil {
+				return nil, err
+			}
+
+			address = signer.Address()
+		default:
+			return nil, fmt.Errorf("unsupported chain %s", account.Chain)
+		}
+
+		if address != account.Address {
+			return nil, fmt.Errorf("bip39 passphrase mismatch")
+		}
+	}
+
+	return wallet, nil
+}
+
+type hdWalletJSON struct {
+	Mnemonic   string     `json:"mnemonic"`
+	Lang       string     `json:"lang"`
+	Passphrase bool       `json:"passphrase"`
+	Accounts   []*Account `json:"accounts"`
+}
+
+func zeroBytes(bytes []byte) {
+	for i := range bytes {
+		bytes[i] = 0
+	}
+}
+
+func derive(master *hdkey.Key, index int, path string) (*hdkey.Key, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("invalid account index %d", index)
+	}
+
+	return master.DerivePath(path)
+}
+
+func addAccount(accounts []*Account, account *Account) []*Account {
+	for _, exists := range accounts {
+		if exists.Index == account.Index {
+			return accounts
+		}
+	}
+
+	return append(accounts, account)
+}
+
+func nextIndex(accounts []*Account) int {
+	next := 0
+
+	for _, account := range accounts {
+		if account.Index >= next {
+			next = account.Index + 1
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,188 @@
+package hdmobiletest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/hdmobile"
+	"github.com/inwecrypto/mobilesdk/neomobile"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestHDWallet(t *testing.T) {
+	wallet, err := hdmobile.NewHDWallet(testMnemonic, "en_US", "")
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	eth, err := wallet.AddETHAccount()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", eth.Address())
+
+	eth, err = wallet.AddETHAccount()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0", eth.Address())
+
+	neo, err := wallet.AddNEOAccount()
+
+	assert.NoError(t, err)
+
+	expected, err := neomobile.FromMnemonicAccount(testMnemonic, "en_US", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected.Address(), neo.Address())
+
+	_, err = wallet.ETHAccount(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, wallet.ETHAccountCount())
+	assert.Equal(t, 1, wallet.NEOAccountCount())
+
+	data, err := wallet.Accounts()
+
+	assert.NoError(t, err)
+
+	var accounts []*hdmobile.Account
+
+	assert.NoError(t, json.Unmarshal([]byte(data), &accounts))
+
+	if assert.Len(t, accounts, 3) {
+		assert.Equal(t, "m/44'/60'/0'/0/1", accounts[1].Path)
+		assert.Equal(t, hdmobile.ChainNEO, accounts[2].Chain)
+		assert.Equal(t, "m/44'/888'/0'/0/0", accounts[2].Path)
+	}
+}
+
+func TestHDWalletPassphrase(t *testing.T) {
+	wallet, err := hdmobile.NewHDWallet(testMnemonic, "en_US", "TREZOR")
+
+	assert.NoError(t, err)
+	assert.True(t, wallet.HasPassphrase())
+
+	eth, err := wallet.ETHAccount(0)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", eth.Address())
+	assert.True(t, eth.UsesPassphrase())
+
+	neo, err := wallet.NEOAccount(0)
+
+	assert.NoError(t, err)
+	assert.True(t, neo.UsesPassphrase())
+}
+
+func TestHDWalletSignerMnemonic(t *testing.T) {
+	wallet, err := hdmobile.NewHDWallet(testMnemonic, "en_US", "")
+
+	assert.NoError(t, err)
+
+	eth, err := wallet.ETHAccount(1)
+
+	assert.NoError(t, err)
+	assert.True(t, eth.HasMnemonic())
+	assert.False(t, eth.UsesPassphrase())
+
+	mnemonic, err := eth.Mnemonic("")
+
+	assert.NoError(t, err)
+	assert.Equal(t, testMnemonic, mnemonic)
+
+	neo, err := wallet.NEOAccount(0)
+
+	assert.NoError(t, err)
+
+	mnemonic, err = neo.Mnemonic("en_US")
+
+	assert.NoError(t, err)
+	assert.Equal(t, testMnemonic, mnemonic)
+}
+
+func TestMnemonicAssist(t *testing.T) {
+	words, err := hdmobile.CompleteMnemonicWord("zo", "en_US", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `["zone","zoo"]`, words)
+
+	report, err := hdmobile.CheckMnemonic(strings.Replace(testMnemonic, "about", "abuot", 1), "", 1)
+
+	assert.NoError(t, err)
+	assert.Contains(t, report, `"valid":false`)
+	assert.Contains(t, report, `"position":11`)
+	assert.Contains(t, report, `"suggestions":["about"]`)
+
+	_, err = hdmobile.CompleteMnemonicWord("zo", "xx_XX", 0)
+
+	assert.Error(t, err)
+}
+
+func TestTranslateMnemonic(t *testing.T) {
+	translated, err := hdmobile.TranslateMnemonic(testMnemonic, "en_US", "zh_CN")
+
+	assert.NoError(t, err)
+
+	back, err := hdmobile.TranslateMnemonic(translated, "", "en_US")
+
+	assert.NoError(t, err)
+	assert.Equal(t, testMnemonic, back)
+}
+
+func TestHDWalletKeyStore(t *testing.T) {
+	wallet, err := hdmobile.NewHDWallet(testMnemonic, "en_US", "TREZOR")
+
+	assert.NoError(t, err)
+
+	_, err = wallet.ETHAccount(0)
+
+	assert.NoError(t, err)
+
+	_, err = wallet.ETHAccount(3)
+
+	assert.NoError(t, err)
+
+	_, err = wallet.NEOAccount(1)
+
+	assert.NoError(t, err)
+
+	keystore, err := wallet.ExportKeyStore("password", nil)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, keystore, "abandon")
+
+	imported, err := hdmobile.ImportHDWallet(keystore, "password", "TREZOR")
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, testMnemonic, imported.Mnemonic())
+	assert.Equal(t, "en_US", imported.Lang())
+	assert.True(t, imported.HasPassphrase())
+
+	expected, _ := wallet.Accounts()
+	accounts, _ := imported.Accounts()
+
+	assert.Equal(t, expected, accounts)
+
+	_, err = imported.AddETHAccount()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, imported.ETHAccountCount())
+
+	_, err = hdmobile.ImportHDWallet(keystore, "wrong", "TREZOR")
+
+	assert.Error(t, err)
+
+	_, err = hdmobile.ImportHDWallet(keystore, "password", "")
+
+	assert.Error(t, err)
+
+	_, err = hdmobile.ImportHDWallet(keystore, "password", "OTHER")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package keystoremobile
+
+import (
+	web3 "github.com/inwecrypto/keystore"
+	"github.com/inwecrypto/mobilesdk/web3keystore"
+)
+
+// KeyStoreOptions keystore kdf options shared by eth and neo wallets, KDF is "scrypt"
+// or "pbkdf2", N/R/P are scrypt parameters and C is pbkdf2 iteration count
+type KeyStoreOptions struct {
+	KDF string
+	N   int
+	R   int
+	P   int
+	C   int
+}
+
+// NewKeyStoreOptions create light scrypt keystore options, same as ToKeyStore uses
+func NewKeyStoreOptions() *KeyStoreOptions {
+	return fromWeb3Options(web3keystore.LightOptions())
+}
+
+// NewStandardKeyStoreOptions create standard scrypt keystore options (N = 262144)
+func NewStandardKeyStoreOptions() *KeyStoreOptions {
+	return fromWeb3Options(web3keystore.StandardOptions())
+}
+
+// NewPBKDF2KeyStoreOptions create pbkdf2 keystore options with iteration count
+func NewPBKDF2KeyStoreOptions(iterations int) *KeyStoreOptions {
+	return fromWeb3Options(web3keystore.PBKDF2Options(iterations))
+}
+
+// CalibrateKeyStore pick the strongest kdf parameters that still decrypt keystore
+// within targetMillis milliseconds on current device
+func CalibrateKeyStore(kdf string, targetMillis int) (*KeyStoreOptions, error) {
+	options, err := web3keystore.Calibrate(kdf, targetMillis)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromWeb3Options(options), nil
+}
+
+// ChangeKeyStorePassword re-encrypt keystore with new password without creating wallet object,
+// nil options keeps the kdf parameters of the original keystore
+func ChangeKeyStorePassword(ks string, oldPassword string, newPassword string, options *KeyStoreOptions) (string, error) {
+	keystore, err := web3keystore.ChangePassword([]byte(ks), oldPassword, newPassword, options.Web3Options())
+
+	return string(keystore), err
+}
+
+// Encrypt write key to keystore format string with kdf options, passphrase marks keys
+// derived from mnemonic with bip39 passphrase. Used by wallet ToKeyStoreWithOptions
+func Encrypt(key *web3.Key, password string, options *KeyStoreOptions, passphrase bool) (string, error) {
+	keystore, err := web3keystore.Encrypt(key, password, options.Web3Options())
+
+	if err != nil || !passphrase {
+		return string(keystore), err
+	}
+
+	keystore, err = web3keystore.WriteMeta(keystore, &web3keystore.Meta{
+		Passphrase: true,
+	})
+
+	return string(keystore), err
+}
+
+// Web3Options convert to web3keystore options, nil options returns nil
+func (options *KeyStoreOptions) Web3Options() *web3keystore.Options {
+	if options == nil {
+		return nil
+	}
+
+	return &web3keystore.Options{
+		KDF: options.KDF,
+		N:   options.N,
+		R:   options.R,
+		P:   options.P,
+		C:   options.C,
+	}
+}
+
+func fromWeb3Options(options *web3keystore.Options) *KeyStoreOptions {
+	return &KeyStoreOptions{
+		KDF: options.KDF,
+		N:   options.N,
+		R:   options.R,
+		P:   options.P,
+		C:   options.C,
+	}
+}
@@ -0,0 +1,172 @@
+package neomobile
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/inwecrypto/neogo/rpc"
+	neotx "github.com/inwecrypto/neogo/tx"
+)
+
+// BalanceChecker on chain activity checker implemented by host app
+type BalanceChecker interface {
+	// HasActivity return true if address holds any asset or has sent any transaction
+	HasActivity(address string) (bool, error)
+}
+
+// MnemonicImport wallet candidates restored from one mnemonic
+type MnemonicImport struct {
+	legacy         *Wallet
+	standard       *Wallet
+	LegacyActive   bool // legacy address has on chain activity
+	StandardActive bool // slip-0010 address has on chain activity
+}
+
+// ImportMnemonic restore both legacy and slip-0010 (m/44'/888'/0'/0/0) wallets from mnemonic,
+// and check on chain activity of both addresses if checker is not nil
+func ImportMnemonic(mnemonic string, lang string, checker BalanceChecker) (*MnemonicImport, error) {
+	standard, err := FromMnemonicAccount(mnemonic, lang, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MnemonicImport{
+		standard: standard,
+	}
+
+	// phrases rejected by the legacy decoder never restored a legacy wallet
+	if legacy, err := FromLegacyMnemonic(mnemonic, lang); err == nil {
+		result.legacy = legacy
+	}
+
+	if checker == nil {
+		return result, nil
+	}
+
+	if result.StandardActive, err = checker.HasActivity(standard.Address()); err != nil {
+		return nil, err
+	}
+
+	if result.legacy != nil {
+		if result.LegacyActive, err = checker.HasActivity(result.legacy.Address()); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Legacy get legacy wallet candidate, return nil if mnemonic can't be a legacy one
+func (imp *MnemonicImport) Legacy() *Wallet {
+	return imp.legacy
+}
+
+// Standard get slip-0010 wallet candidate
+func (imp *MnemonicImport) Standard() *Wallet {
+	return imp.standard
+}
+
+// HasLegacy check if mnemonic restores a legacy wallet
+func (imp *MnemonicImport) HasLegacy() bool {
+	return imp.legacy != nil
+}
+
+// NeedMigration check if funds should be moved from legacy address to slip-0010 address
+func (imp *MnemonicImport) NeedMigration() bool {
+	return imp.legacy != nil && imp.LegacyActive
+}
+
+// MigrateAssertTx create tx moving all the global asset utxos of legacy address to slip-0010 address
+func (imp *MnemonicImport) MigrateAssertTx(assert string, unspent string) (*Tx, error) {
+	if imp.legacy == nil {
+		return nil, fmt.Errorf("mnemonic has no legacy wallet")
+	}
+
+	var utxos []*rpc.UTXO
+
+	if err := json.Unmarshal([]byte(unspent), &utxos); err != nil {
+		return nil, err
+	}
+
+	tx := neotx.NewContractTx()
+
+	total := new(big.Int)
+
+	for _, utxo := range utxos {
+		if utxo.Vout.Asset != assert || utxo.Vout.Address != imp.legacy.Address() {
+			continue
+		}
+
+		value, err := parseFixed8(utxo.Vout.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		total.Add(total, value)
+
+		tx.Inputs = append(tx.Inputs, &neotx.Vin{
+			Tx: utxo.TransactionID,
+			N:  uint16(utxo.Vout.N),
+		})
+	}
+
+	if total.Sign() == 0 {
+		return nil, fmt.Errorf("legacy address has no utxo of asset %s", assert)
+	}
+
+	if !total.IsInt64() {
+		return nil, fmt.Errorf("asset %s amount overflow", assert)
+	}
+
+	// all utxos are spent to slip-0010 address, no change output
+	tx.Outputs = []*neotx.Vout{
+		&neotx.Vout{
+			Asset:   assert,
+			Value:   neotx.Fixed8(total.Int64()),
+			Address: imp.standard.Address(),
+		},
+	}
+
+	return imp.legacy.signTx(tx.Tx())
+}
+
+// parseFixed8 parse decimal utxo value into 10^-8 units without float rounding
+func parseFixed8(value string) (*big.Int, error) {
+	amount, ok := new(big.Rat).SetString(value)
+
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid utxo value %s", value)
+	}
+
+	amount.Mul(amount, big.NewRat(100000000, 1))
+
+	if !amount.IsInt() {
+		return nil, fmt.Errorf("utxo value %s has more than 8 decimals", value)
+	}
+
+	return amount.Num(), nil
+}
+
+// MigrateNep5Tx create tx moving nep5 token amount from legacy address to slip-0010 address
+func (imp *MnemonicImport) MigrateNep5Tx(asset string, amount int64, unspent string) (*Tx, error) {
+	if imp.legacy == nil {
+		return nil, fmt.Errorf("mnemonic has no legacy wallet")
+	}
+
+	from, err := DecodeAddress(imp.legacy.Address())
+
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := DecodeAddress(imp.standard.Address())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return imp.legacy.CreateNep5Tx(asset, from, to, amount, unspent)
+}
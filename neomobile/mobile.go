@@ -1,22 +1,34 @@
 package neomobile
 
 import (
+	"crypto/elliptic"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"strings"
 	"time"
 
 	"github.com/inwecrypto/bip39"
+	"github.com/inwecrypto/mobilesdk/hdkey"
+	"github.com/inwecrypto/mobilesdk/nep2"
+	"github.com/inwecrypto/mobilesdk/seedphrase"
+	"github.com/inwecrypto/mobilesdk/web3keystore"
 	"github.com/inwecrypto/neogo/keystore"
 	"github.com/inwecrypto/neogo/nep5"
 	"github.com/inwecrypto/neogo/rpc"
 	neotx "github.com/inwecrypto/neogo/tx"
 )
 
+// NEOPath bip44 derivation path of neo account, %d is the account index
+const NEOPath = "m/44'/888'/0'/0/%d"
+
 // Wallet neo mobile wallet
 type Wallet struct {
-	key *keystore.Key
+	key        *keystore.Key
+	passphrase bool   // key is derived from bip39 seed protected by passphrase
+	mnemonic   string // normalized mnemonic, empty if wallet is not created from mnemonic
+	lang       string // mnemonic language
 }
 
 // Tx neo rawtx wrapper
@@ -38,9 +50,15 @@ func FromWIF(wif string) (*Wallet, error) {
 	}, nil
 }
 
-// New create a new wallet
-func New() (*Wallet, error) {
-	key, err := keystore.NewKey()
+// FromPrivateKey create wallet from hex format private key
+func FromPrivateKey(privateKey string) (*Wallet, error) {
+	bytes, err := hex.DecodeString(strings.TrimPrefix(privateKey, "0x"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.KeyFromPrivateKey(bytes)
 
 	if err != nil {
 		return nil, err
@@ -51,9 +69,62 @@ func New() (*Wallet, error) {
 	}, nil
 }
 
-// FromMnemonic create wallet from mnemonic
+// FromNEP2 create wallet from nep2 encrypted private key
+func FromNEP2(encrypted string, passphrase string) (*Wallet, error) {
+	key, err := nep2.Decrypt(encrypted, passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Wallet{
+		key: key,
+	}, nil
+}
+
+// New create a new wallet from a random 12 words mnemonic, the mnemonic can be
+// exported with Wallet.Mnemonic
+func New() (*Wallet, error) {
+	mnemonic, err := seedphrase.Generate(12, "en_US", nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FromMnemonicAccount(mnemonic, "en_US", 0)
+}
+
+// GenerateMnemonic create random mnemonic with words count 12, 15, 18, 21 or 24
+func GenerateMnemonic(words int, lang string) (string, error) {
+	return seedphrase.Generate(words, lang, nil)
+}
+
+// GenerateMnemonicWithEntropy create random mnemonic with extra entropy supplied by
+// host app such as dice rolls, which is mixed with system random bytes
+func GenerateMnemonicWithEntropy(words int, lang string, extraEntropy []byte) (string, error) {
+	return seedphrase.Generate(words, lang, extraEntropy)
+}
+
+// DetectMnemonicLanguage detect mnemonic language, result can be used as lang parameter
+func DetectMnemonicLanguage(mnemonic string) (string, error) {
+	return seedphrase.DetectLanguage(mnemonic)
+}
+
+// FromMnemonic create wallet of the first account derived from mnemonic, empty lang
+// means detect language automatically. Mnemonic exported by old versions must be
+// imported with FromLegacyMnemonic or ImportMnemonic
 func FromMnemonic(mnemonic string, lang string) (*Wallet, error) {
-	dic, _ := bip39.GetDict(lang)
+	return FromMnemonicAccount(mnemonic, lang, 0)
+}
+
+// FromLegacyMnemonic create wallet from mnemonic generated by Wallet.LegacyMnemonic,
+// which encodes the private key itself as bip39 entropy
+func FromLegacyMnemonic(mnemonic string, lang string) (*Wallet, error) {
+	_, dic, err := seedphrase.ResolveDict(mnemonic, lang)
+
+	if err != nil {
+		return nil, err
+	}
 
 	data, err := bip39.MnemonicToByteArray(mnemonic, dic)
 
@@ -63,8 +134,6 @@ func FromMnemonic(mnemonic string, lang string) (*Wallet, error) {
 
 	data = data[1 : len(data)-1]
 
-	println(hex.EncodeToString(data))
-
 	key, err := keystore.KeyFromPrivateKey(data)
 
 	if err != nil {
@@ -76,6 +145,75 @@ func FromMnemonic(mnemonic string, lang string) (*Wallet, error) {
 	}, nil
 }
 
+// FromMnemonicAccount create wallet from mnemonic with slip-0010 path m/44'/888'/0'/0/index
+func FromMnemonicAccount(mnemonic string, lang string, index int) (*Wallet, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("invalid account index %d", index)
+	}
+
+	return FromMnemonicPath(mnemonic, lang, fmt.Sprintf(NEOPath, index))
+}
+
+// FromMnemonicPath create wallet from mnemonic with custom slip-0010 derivation path
+func FromMnemonicPath(mnemonic string, lang string, path string) (*Wallet, error) {
+	return FromMnemonicPathWithPassphrase(mnemonic, lang, "", path)
+}
+
+// FromMnemonicWithPassphrase create wallet from mnemonic and bip39 passphrase with account index
+func FromMnemonicWithPassphrase(mnemonic string, lang string, passphrase string, index int) (*Wallet, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("invalid account index %d", index)
+	}
+
+	return FromMnemonicPathWithPassphrase(mnemonic, lang, passphrase, fmt.Sprintf(NEOPath, index))
+}
+
+// FromMnemonicPathWithPassphrase create wallet from mnemonic and bip39 passphrase with custom slip-0010 derivation path
+func FromMnemonicPathWithPassphrase(mnemonic string, lang string, passphrase string, path string) (*Wallet, error) {
+	lang, dic, err := seedphrase.ResolveDict(mnemonic, lang)
+
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := seedphrase.NewSeed(mnemonic, passphrase, dic)
+
+	if err != nil {
+		return nil, err
+	}
+
+	master, err := hdkey.NewMasterKey(seed, elliptic.P256())
+
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := master.DerivePath(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FromHDKey(child, mnemonic, lang, passphrase != "")
+}
+
+// FromHDKey create wallet of key derived from mnemonic, keeping the mnemonic context
+// so that Mnemonic and keystore metadata are the same as FromMnemonicPathWithPassphrase
+func FromHDKey(child *hdkey.Key, mnemonic string, lang string, passphrase bool) (*Wallet, error) {
+	key, err := keystore.KeyFromPrivateKey(child.PrivateKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Wallet{
+		key:        key,
+		passphrase: passphrase,
+		mnemonic:   seedphrase.Normalize(mnemonic),
+		lang:       lang,
+	}, nil
+}
+
 // FromKeyStore create wallet from keystore
 func FromKeyStore(ks string, password string) (*Wallet, error) {
 	key, err := keystore.ReadKeyStore([]byte(ks), password)
@@ -84,16 +222,41 @@ func FromKeyStore(ks string, password string) (*Wallet, error) {
 		return nil, err
 	}
 
+	meta, err := web3keystore.ReadMeta([]byte(ks))
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &Wallet{
-		key: key,
+		key:        key,
+		passphrase: meta.Passphrase,
 	}, nil
 }
 
-// ToKeyStore write wallet to keystore format string
+// ToKeyStore write wallet to light scrypt keystore format string
 func (wrapper *Wallet) ToKeyStore(password string) (string, error) {
-	keystore, err := keystore.WriteLightScryptKeyStore(wrapper.key, password)
+	return wrapper.ToKeyStoreWithOptions(password, nil)
+}
 
-	return string(keystore), err
+// ToNEP2 write wallet private key to nep2 encrypted format string
+func (wrapper *Wallet) ToNEP2(passphrase string) (string, error) {
+	return nep2.Encrypt(wrapper.key, passphrase)
+}
+
+// HasMnemonic check if wallet is created from mnemonic
+func (wrapper *Wallet) HasMnemonic() bool {
+	return wrapper.mnemonic != ""
+}
+
+// MnemonicLang get language of the mnemonic wallet is created from
+func (wrapper *Wallet) MnemonicLang() string {
+	return wrapper.lang
+}
+
+// UsesPassphrase check if wallet is derived from bip39 seed protected by passphrase
+func (wrapper *Wallet) UsesPassphrase() bool {
+	return wrapper.passphrase
 }
 
 // CreateAssertTx create assert transfer raw tx
@@ -126,7 +289,12 @@ func (wrapper *Wallet) CreateAssertTx(assert, from, to string, amount float64, u
 	// 	return nil, err
 	// }
 
-	rawtxdata, txid, err := tx.Tx().Sign(wrapper.key.PrivateKey)
+	return wrapper.signTx(tx.Tx())
+}
+
+// signTx sign tx with wallet key
+func (wrapper *Wallet) signTx(tx *neotx.Transaction) (*Tx, error) {
+	rawtxdata, txid, err := tx.Sign(wrapper.key.PrivateKey)
 
 	return &Tx{
 		Data: hex.EncodeToString(rawtxdata),
@@ -139,21 +307,31 @@ func (wrapper *Wallet) Address() string {
 	return wrapper.key.Address
 }
 
-// Mnemonic gete mnemonic string
+// Mnemonic get the mnemonic wallet is created from, lang must be empty or the
+// mnemonic's language since bip39 seed depends on the words. Wallets not created
+// from mnemonic have no phrase and return an error, see HasMnemonic
 func (wrapper *Wallet) Mnemonic(lang string) (string, error) {
-	privateKeyBytes := wrapper.key.ToBytes()
+	if wrapper.mnemonic == "" {
+		return "", fmt.Errorf("wallet is not created from mnemonic")
+	}
 
-	dic, _ := bip39.GetDict(lang)
+	if lang != "" && lang != wrapper.lang {
+		return "", fmt.Errorf("wallet mnemonic language is %s", wrapper.lang)
+	}
 
-	println(hex.EncodeToString(privateKeyBytes))
+	return wrapper.mnemonic, nil
+}
 
-	data, err := bip39.NewMnemonic(privateKeyBytes, dic)
+// LegacyMnemonic encode private key itself as bip39 entropy, the phrase is not
+// a bip39 seed phrase and can only be imported with FromLegacyMnemonic
+func (wrapper *Wallet) LegacyMnemonic(lang string) (string, error) {
+	dic, err := seedphrase.Dict(lang)
 
 	if err != nil {
 		return "", err
 	}
 
-	return data, nil
+	return bip39.NewMnemonic(wrapper.key.ToBytes(), dic)
 }
 
 // CreateClaimTx create claim tx
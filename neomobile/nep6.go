@@ -0,0 +1,115 @@
+package neomobile
+
+import (
+	"encoding/json"
+
+	"github.com/inwecrypto/mobilesdk/nep6"
+)
+
+// NEP6Wallet nep6 wallet file wrapper, account keys are decrypted only when opened
+type NEP6Wallet struct {
+	wallet *nep6.Wallet
+}
+
+// NewNEP6Wallet create empty nep6 wallet file
+func NewNEP6Wallet(name string) *NEP6Wallet {
+	return &NEP6Wallet{
+		wallet: nep6.New(name),
+	}
+}
+
+// ReadNEP6 read nep6 wallet json
+func ReadNEP6(data string) (*NEP6Wallet, error) {
+	wallet, err := nep6.Read([]byte(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &NEP6Wallet{
+		wallet: wallet,
+	}, nil
+}
+
+// Name get wallet name
+func (wrapper *NEP6Wallet) Name() string {
+	return wrapper.wallet.Name
+}
+
+// Count get account count
+func (wrapper *NEP6Wallet) Count() int {
+	return len(wrapper.wallet.Accounts)
+}
+
+// DefaultIndex get default account index, -1 if wallet is empty
+func (wrapper *NEP6Wallet) DefaultIndex() int {
+	return wrapper.wallet.DefaultIndex()
+}
+
+// Address get account address at index
+func (wrapper *NEP6Wallet) Address(index int) (string, error) {
+	account, err := wrapper.account(index)
+
+	if err != nil {
+		return "", err
+	}
+
+	return account.Address, nil
+}
+
+// Label get account label at index
+func (wrapper *NEP6Wallet) Label(index int) (string, error) {
+	account, err := wrapper.account(index)
+
+	if err != nil {
+		return "", err
+	}
+
+	return account.Label, nil
+}
+
+// IsWatchOnly check if account at index has no private key
+func (wrapper *NEP6Wallet) IsWatchOnly(index int) (bool, error) {
+	account, err := wrapper.account(index)
+
+	if err != nil {
+		return false, err
+	}
+
+	return account.Key == "", nil
+}
+
+// Open decrypt account at index as wallet
+func (wrapper *NEP6Wallet) Open(index int, passphrase string) (*Wallet, error) {
+	key, err := wrapper.wallet.Decrypt(index, passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Wallet{
+		key: key,
+	}, nil
+}
+
+// Add encrypt wallet key and append it as new account
+func (wrapper *NEP6Wallet) Add(wallet *Wallet, label string, passphrase string, isDefault bool) error {
+	_, err := wrapper.wallet.Add(wallet.key, label, passphrase, isDefault)
+
+	return err
+}
+
+// ToJSON write nep6 wallet json
+func (wrapper *NEP6Wallet) ToJSON() (string, error) {
+	data, err := json.Marshal(wrapper.wallet)
+
+	return string(data), err
+}
+
+func (wrapper *NEP6Wallet) account(index int) (*nep6.Account, error) {
+	if index < 0 || index >= len(wrapper.wallet.Accounts) {
+		return nil, nep6.ErrAccountIndex
+	}
+
+	return wrapper.wallet.Accounts[index], nil
+}
@@ -0,0 +1,17 @@
+package neomobile
+
+import (
+	web3 "github.com/inwecrypto/keystore"
+	"github.com/inwecrypto/mobilesdk/keystoremobile"
+)
+
+// ToKeyStoreWithOptions write wallet to keystore format string with kdf options
+func (wrapper *Wallet) ToKeyStoreWithOptions(password string, options *keystoremobile.KeyStoreOptions) (string, error) {
+	key := &web3.Key{
+		ID:         wrapper.key.ID,
+		Address:    wrapper.key.Address,
+		PrivateKey: wrapper.key.ToBytes(),
+	}
+
+	return keystoremobile.Encrypt(key, password, options, wrapper.passphrase)
+}
@@ -1,14 +1,21 @@
 package neomobiletest
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/inwecrypto/mobilesdk/keystoremobile"
 	"github.com/inwecrypto/mobilesdk/neomobile"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestMem(t *testing.T) {
-	wallet, err := neomobile.New()
+	mnemonic, err := neomobile.GenerateMnemonic(12, "zh_CN")
+
+	assert.NoError(t, err)
+
+	wallet, err := neomobile.FromMnemonic(mnemonic, "zh_CN")
 
 	assert.NoError(t, err)
 
@@ -17,10 +24,221 @@ func TestMem(t *testing.T) {
 	println(mne)
 
 	assert.NoError(t, err)
+	assert.Equal(t, mnemonic, mne)
 
 	wallet2, err := neomobile.FromMnemonic(mne, "zh_CN")
 
 	assert.NoError(t, err)
 
 	assert.Equal(t, wallet.Address(), wallet2.Address())
+
+	_, err = wallet.Mnemonic("en_US")
+
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	wallet, err := neomobile.New()
+
+	assert.NoError(t, err)
+	assert.True(t, wallet.HasMnemonic())
+
+	mnemonic, err := wallet.Mnemonic("")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12, len(strings.Fields(mnemonic)))
+
+	wallet2, err := neomobile.FromMnemonic(mnemonic, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), wallet2.Address())
+}
+
+func TestFromMnemonicAccount(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	// expected addresses computed by an independent slip-0010 nist256p1 implementation
+	wallet, err := neomobile.FromMnemonicAccount(mnemonic, "en_US", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AJHeWQn2qKKqD4nBE82etebgT3GEM9HDRH", wallet.Address())
+
+	wallet2, err := neomobile.FromMnemonicPath(mnemonic, "en_US", "m/44'/888'/0'/0/0")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), wallet2.Address())
+
+	wallet3, err := neomobile.FromMnemonicAccount(mnemonic, "en_US", 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AYrG8CHdxTMiWiKDT1cZWvLh6WENaQZsRg", wallet3.Address())
+}
+
+type activityChecker map[string]bool
+
+func (checker activityChecker) HasActivity(address string) (bool, error) {
+	return checker[address], nil
+}
+
+func TestImportLegacyMnemonic(t *testing.T) {
+	wallet, err := neomobile.FromWIF("L44B5gGEpqEDRS9vVPz7QT35jcBG2r3CZwSwQ4fCewXAhAhqGVpP")
+
+	assert.NoError(t, err)
+	assert.False(t, wallet.HasMnemonic())
+
+	_, err = wallet.Mnemonic("en_US")
+
+	assert.Error(t, err)
+
+	mnemonic, err := wallet.LegacyMnemonic("en_US")
+
+	assert.NoError(t, err)
+
+	imported, err := neomobile.ImportMnemonic(mnemonic, "en_US", activityChecker{wallet.Address(): true})
+
+	if !assert.NoError(t, err) || !assert.True(t, imported.HasLegacy()) {
+		return
+	}
+
+	assert.Equal(t, wallet.Address(), imported.Legacy().Address())
+	assert.NotEqual(t, wallet.Address(), imported.Standard().Address())
+	assert.True(t, imported.NeedMigration())
+
+	unspent := `[{"txid":"0x9e4d9c5d8ba4e2e0e04c3e5d4c1f4e8e2e8c3a5d5c1a0e6c3d5e2b1c9a8d7f6e","vout":{"Address":"` + wallet.Address() + `","Asset":"0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b","N":0,"Value":"10"}}]`
+
+	tx, err := imported.MigrateAssertTx("0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b", unspent)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tx.ID)
+
+	// 0.00000001 + 12345678.12345678 + 0.1 summed exactly in 10^-8 units
+	utxo := `{"txid":"0x9e4d9c5d8ba4e2e0e04c3e5d4c1f4e8e2e8c3a5d5c1a0e6c3d5e2b1c9a8d7f6e","vout":{"Address":"` + wallet.Address() + `","Asset":"0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7","N":%d,"Value":"%s"}}`
+
+	unspent = "[" + fmt.Sprintf(utxo, 0, "0.00000001") + "," + fmt.Sprintf(utxo, 1, "12345678.12345678") + "," + fmt.Sprintf(utxo, 2, "0.1") + "]"
+
+	tx, err = imported.MigrateAssertTx("0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7", unspent)
+
+	assert.NoError(t, err)
+	assert.Contains(t, tx.Data, "cf858038d5620400")
+
+	unspent = "[" + fmt.Sprintf(utxo, 0, "0.123456789") + "]"
+
+	_, err = imported.MigrateAssertTx("0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7", unspent)
+
+	assert.Error(t, err)
+}
+
+func TestFromMnemonicWithPassphrase(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	wallet, err := neomobile.FromMnemonicAccount(mnemonic, "en_US", 0)
+
+	assert.NoError(t, err)
+
+	hidden, err := neomobile.FromMnemonicWithPassphrase(mnemonic, "en_US", "TREZOR", 0)
+
+	assert.NoError(t, err)
+	assert.True(t, hidden.UsesPassphrase())
+	assert.NotEqual(t, wallet.Address(), hidden.Address())
+
+	ks, err := hidden.ToKeyStore("test")
+
+	assert.NoError(t, err)
+
+	restored, err := neomobile.FromKeyStore(ks, "test")
+
+	assert.NoError(t, err)
+	assert.Equal(t, hidden.Address(), restored.Address())
+	assert.True(t, restored.UsesPassphrase())
+}
+
+func TestNEP2(t *testing.T) {
+	wallet, err := neomobile.FromNEP2("6PYVPVe1fQznphjbUxXP9KZJqPMVnVwCx5s5pr5axRJ8uHkMtZg97eT5kL", "TestingOneTwoThree")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AStZHy8E6StCqYQbzMqi4poH7YNDHQKxvt", wallet.Address())
+
+	encrypted, err := wallet.ToNEP2("TestingOneTwoThree")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "6PYVPVe1fQznphjbUxXP9KZJqPMVnVwCx5s5pr5axRJ8uHkMtZg97eT5kL", encrypted)
+}
+
+func TestNEP6(t *testing.T) {
+	wallet, err := neomobile.FromWIF("L44B5gGEpqEDRS9vVPz7QT35jcBG2r3CZwSwQ4fCewXAhAhqGVpP")
+
+	assert.NoError(t, err)
+
+	nep6Wallet := neomobile.NewNEP6Wallet("test")
+
+	assert.NoError(t, nep6Wallet.Add(wallet, "main", "TestingOneTwoThree", true))
+
+	data, err := nep6Wallet.ToJSON()
+
+	assert.NoError(t, err)
+
+	nep6Wallet, err = neomobile.ReadNEP6(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, nep6Wallet.Count())
+	assert.Equal(t, 0, nep6Wallet.DefaultIndex())
+
+	address, err := nep6Wallet.Address(0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), address)
+
+	label, err := nep6Wallet.Label(0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "main", label)
+
+	_, err = nep6Wallet.Open(0, "wrong")
+
+	assert.Error(t, err)
+
+	opened, err := nep6Wallet.Open(0, "TestingOneTwoThree")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), opened.Address())
+}
+
+func TestKeyStoreOptions(t *testing.T) {
+	wallet, err := neomobile.FromWIF("L44B5gGEpqEDRS9vVPz7QT35jcBG2r3CZwSwQ4fCewXAhAhqGVpP")
+
+	assert.NoError(t, err)
+
+	ks, err := wallet.ToKeyStoreWithOptions("password", keystoremobile.NewPBKDF2KeyStoreOptions(1000))
+
+	assert.NoError(t, err)
+
+	restored, err := neomobile.FromKeyStore(ks, "password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), restored.Address())
+
+	options, err := keystoremobile.CalibrateKeyStore("scrypt", 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "scrypt", options.KDF)
+	assert.Equal(t, 1<<14, options.N)
+}
+
+func TestChangeKeyStorePassword(t *testing.T) {
+	wallet, err := neomobile.FromWIF("L44B5gGEpqEDRS9vVPz7QT35jcBG2r3CZwSwQ4fCewXAhAhqGVpP")
+
+	assert.NoError(t, err)
+
+	ks, err := wallet.ToKeyStore("old")
+
+	assert.NoError(t, err)
+
+	changed, err := keystoremobile.ChangeKeyStorePassword(ks, "old", "new", keystoremobile.NewPBKDF2KeyStoreOptions(1000))
+
+	assert.NoError(t, err)
+
+	restored, err := neomobile.FromKeyStore(changed, "new")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address(), restored.Address())
 }
@@ -0,0 +1,165 @@
+package nep2
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/inwecrypto/neogo/keystore"
+	"golang.org/x/crypto/scrypt"
+)
+
+// NEP-2 default scrypt parameters
+const (
+	ScryptN = 16384
+	ScryptR = 8
+	ScryptP = 8
+)
+
+// nep2 payload is 0x01 0x42 0xe0 || addresshash || encryptedkey, the first byte
+// is passed to base58 check codec as version byte
+const (
+	nep2Version = 0x01
+	nep2Len     = 38
+)
+
+var nep2Flag = []byte{0x42, 0xe0}
+
+// Errors
+var (
+	ErrInvalidNEP2 = errors.New("nep2: invalid encrypted key")
+	ErrDecrypt     = errors.New("nep2: could not decrypt key with given passphrase")
+)
+
+// Encrypt encrypt key as nep2 string with default scrypt parameters
+func Encrypt(key *keystore.Key, passphrase string) (string, error) {
+	return EncryptWithParams(key, passphrase, ScryptN, ScryptR, ScryptP)
+}
+
+// EncryptWithParams encrypt key as nep2 string with custom scrypt parameters
+func EncryptWithParams(key *keystore.Key, passphrase string, n, r, p int) (string, error) {
+	addressHash := addressHash(key.Address)
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), addressHash, n, r, p, 64)
+
+	if err != nil {
+		return "", err
+	}
+
+	privateKey := key.ToBytes()
+
+	xor(privateKey, derivedKey[:32])
+
+	encrypted, err := aesECB(derivedKey[32:], privateKey, false)
+
+	if err != nil {
+		return "", err
+	}
+
+	payload := make([]byte, 0, nep2Len)
+	payload = append(payload, nep2Flag...)
+	payload = append(payload, addressHash...)
+	payload = append(payload, encrypted...)
+
+	zeroBytes(privateKey)
+	zeroBytes(derivedKey)
+
+	return base58.CheckEncode(payload, nep2Version), nil
+}
+
+// Decrypt decrypt nep2 string with default scrypt parameters
+func Decrypt(nep2 string, passphrase string) (*keystore.Key, error) {
+	return DecryptWithParams(nep2, passphrase, ScryptN, ScryptR, ScryptP)
+}
+
+// DecryptWithParams decrypt nep2 string with custom scrypt parameters
+func DecryptWithParams(nep2 string, passphrase string, n, r, p int) (*keystore.Key, error) {
+	payload, version, err := base58.CheckDecode(nep2)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if version != nep2Version || len(payload) != nep2Len || !bytes.Equal(payload[:2], nep2Flag) {
+		return nil, ErrInvalidNEP2
+	}
+
+	hash := payload[2:6]
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), hash, n, r, p, 64)
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := aesECB(derivedKey[32:], payload[6:], true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	xor(privateKey, derivedKey[:32])
+
+	zeroBytes(derivedKey)
+
+	key, err := keystore.KeyFromPrivateKey(privateKey)
+
+	zeroBytes(privateKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(addressHash(key.Address), hash) {
+		return nil, ErrDecrypt
+	}
+
+	return key, nil
+}
+
+// addressHash first 4 bytes of sha256(sha256(address))
+func addressHash(address string) []byte {
+	hash1 := sha256.Sum256([]byte(address))
+	hash2 := sha256.Sum256(hash1[:])
+
+	return hash2[:4]
+}
+
+// aesECB aes-256-ecb without padding, data length must be multiple of block size
+func aesECB(key, data []byte, decrypt bool) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data)%aes.BlockSize != 0 {
+		return nil, ErrInvalidNEP2
+	}
+
+	result := make([]byte, len(data))
+
+	for i := 0; i < len(data); i += aes.BlockSize {
+		if decrypt {
+			block.Decrypt(result[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		} else {
+			block.Encrypt(result[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		}
+	}
+
+	return result, nil
+}
+
+func xor(data []byte, key []byte) {
+	for i := range data {
+		data[i] ^= key[i]
+	}
+}
+
+func zeroBytes(bytes []byte) {
+	for i := range bytes {
+		bytes[i] = 0
+	}
+}
@@ -0,0 +1,47 @@
+package nep2test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/nep2"
+	"github.com/inwecrypto/neogo/keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+// test vector from NEP-2 specification
+const (
+	testAddress    = "AStZHy8E6StCqYQbzMqi4poH7YNDHQKxvt"
+	testWIF        = "L44B5gGEpqEDRS9vVPz7QT35jcBG2r3CZwSwQ4fCewXAhAhqGVpP"
+	testPrivateKey = "cbf4b9f70470856bb4f40f80b87edb90865997ffee6df315ab166d713af433a5"
+	testPassphrase = "TestingOneTwoThree"
+	testNEP2       = "6PYVPVe1fQznphjbUxXP9KZJqPMVnVwCx5s5pr5axRJ8uHkMtZg97eT5kL"
+)
+
+func TestEncrypt(t *testing.T) {
+	key, err := keystore.KeyFromWIF(testWIF)
+
+	assert.NoError(t, err)
+	assert.Equal(t, testAddress, key.Address)
+
+	encrypted, err := nep2.Encrypt(key, testPassphrase)
+
+	assert.NoError(t, err)
+	assert.Equal(t, testNEP2, encrypted)
+}
+
+func TestDecrypt(t *testing.T) {
+	key, err := nep2.Decrypt(testNEP2, testPassphrase)
+
+	assert.NoError(t, err)
+	assert.Equal(t, testAddress, key.Address)
+	assert.Equal(t, testPrivateKey, hex.EncodeToString(key.ToBytes()))
+
+	_, err = nep2.Decrypt(testNEP2, "wrong passphrase")
+
+	assert.Equal(t, nep2.ErrDecrypt, err)
+
+	_, err = nep2.Decrypt(testWIF, testPassphrase)
+
+	assert.Error(t, err)
+}
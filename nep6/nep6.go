@@ -0,0 +1,194 @@
+package nep6
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/inwecrypto/mobilesdk/nep2"
+	"github.com/inwecrypto/neogo/keystore"
+)
+
+// Version nep6 wallet file version
+const Version = "1.0"
+
+// Errors
+var (
+	ErrAccountIndex = errors.New("nep6: account index out of range")
+	ErrWatchOnly    = errors.New("nep6: watch-only account has no key")
+	ErrAddress      = errors.New("nep6: decrypted key does not match account address")
+)
+
+// Wallet nep6 wallet file
+type Wallet struct {
+	Name     string      `json:"name"`
+	Version  string      `json:"version"`
+	Scrypt   Scrypt      `json:"scrypt"`
+	Accounts []*Account  `json:"accounts"`
+	Extra    interface{} `json:"extra"`
+}
+
+// Scrypt nep2 scrypt parameters used by all accounts of wallet
+type Scrypt struct {
+	N int `json:"n"`
+	R int `json:"r"`
+	P int `json:"p"`
+}
+
+// Account nep6 account, key is nep2 encrypted private key or empty for watch-only account
+type Account struct {
+	Address   string      `json:"address"`
+	Label     string      `json:"label"`
+	IsDefault bool        `json:"isDefault"`
+	Lock      bool        `json:"lock"`
+	Key       string      `json:"key"`
+	Contract  *Contract   `json:"contract"`
+	Extra     interface{} `json:"extra"`
+}
+
+// Contract account verification contract
+type Contract struct {
+	Script     string      `json:"script"`
+	Parameters []Parameter `json:"parameters"`
+	Deployed   bool        `json:"deployed"`
+}
+
+// Parameter contract parameter
+type Parameter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// New create empty nep6 wallet with default scrypt parameters
+func New(name string) *Wallet {
+	return &Wallet{
+		Name:    name,
+		Version: Version,
+		Scrypt: Scrypt{
+			N: nep2.ScryptN,
+			R: nep2.ScryptR,
+			P: nep2.ScryptP,
+		},
+		Accounts: make([]*Account, 0),
+	}
+}
+
+// Read parse nep6 wallet json
+func Read(data []byte) (*Wallet, error) {
+	var wallet *Wallet
+
+	if err := json.Unmarshal(data, &wallet); err != nil {
+		return nil, err
+	}
+
+	if wallet == nil || wallet.Scrypt.N <= 0 || wallet.Scrypt.R <= 0 || wallet.Scrypt.P <= 0 {
+		return nil, fmt.Errorf("nep6: invalid scrypt parameters")
+	}
+
+	for i, account := range wallet.Accounts {
+		if account == nil || account.Address == "" {
+			return nil, fmt.Errorf("nep6: account %d has no address", i)
+		}
+	}
+
+	return wallet, nil
+}
+
+// Add encrypt key with wallet's scrypt parameters and append it as a new standard account
+func (wallet *Wallet) Add(key *keystore.Key, label string, passphrase string, isDefault bool) (*Account, error) {
+	encrypted, err := nep2.EncryptWithParams(key, passphrase, wallet.Scrypt.N, wallet.Scrypt.R, wallet.Scrypt.P)
+
+	if err != nil {
+		return nil, err
+	}
+
+	account := &Account{
+		Address: key.Address,
+		Label:   label,
+		Key:     encrypted,
+		Contract: &Contract{
+			Script: hex.EncodeToString(VerificationScript(PublicKey(key))),
+			Parameters: []Parameter{
+				{Name: "signature", Type: "Signature"},
+			},
+		},
+	}
+
+	if isDefault || len(wallet.Accounts) == 0 {
+		for _, other := range wallet.Accounts {
+			other.IsDefault = false
+		}
+
+		account.IsDefault = true
+	}
+
+	wallet.Accounts = append(wallet.Accounts, account)
+
+	return account, nil
+}
+
+// Decrypt decrypt the private key of account at index
+func (wallet *Wallet) Decrypt(index int, passphrase string) (*keystore.Key, error) {
+	if index < 0 || index >= len(wallet.Accounts) {
+		return nil, ErrAccountIndex
+	}
+
+	account := wallet.Accounts[index]
+
+	if account.Key == "" {
+		return nil, ErrWatchOnly
+	}
+
+	key, err := nep2.DecryptWithParams(account.Key, passphrase, wallet.Scrypt.N, wallet.Scrypt.R, wallet.Scrypt.P)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Address != account.Address {
+		return nil, ErrAddress
+	}
+
+	return key, nil
+}
+
+// DefaultIndex get default account index, returns 0 if no account marked as default
+// and -1 if wallet is empty
+func (wallet *Wallet) DefaultIndex() int {
+	for i, account := range wallet.Accounts {
+		if account.IsDefault {
+			return i
+		}
+	}
+
+	if len(wallet.Accounts) == 0 {
+		return -1
+	}
+
+	return 0
+}
+
+// PublicKey get compressed public key of key
+func PublicKey(key *keystore.Key) []byte {
+	x := key.PrivateKey.PublicKey.X.Bytes()
+
+	pubkey := make([]byte, 33)
+
+	pubkey[0] = 0x02
+
+	if key.PrivateKey.PublicKey.Y.Bit(0) == 1 {
+		pubkey[0] = 0x03
+	}
+
+	copy(pubkey[33-len(x):], x)
+
+	return pubkey
+}
+
+// VerificationScript standard single signature contract script: PUSHBYTES33 pubkey CHECKSIG
+func VerificationScript(pubkey []byte) []byte {
+	script := append([]byte{0x21}, pubkey...)
+
+	return append(script, 0xac)
+}
@@ -0,0 +1,111 @@
+package nep6test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/nep6"
+	"github.com/inwecrypto/neogo/keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+const testWallet = `{
+	"name": "MyWallet",
+	"version": "1.0",
+	"scrypt": {"n": 16384, "r": 8, "p": 8},
+	"accounts": [
+		{
+			"address": "AQLASLtT6pWbThcSCYU1biVqhMnzhTgLFq",
+			"label": "watch",
+			"isDefault": false,
+			"lock": false,
+			"key": null,
+			"contract": null,
+			"extra": null
+		},
+		{
+			"address": "AStZHy8E6StCqYQbzMqi4poH7YNDHQKxvt",
+			"label": "MyAddress",
+			"isDefault": true,
+			"lock": false,
+			"key": "6PYVPVe1fQznphjbUxXP9KZJqPMVnVwCx5s5pr5axRJ8uHkMtZg97eT5kL",
+			"contract": {
+				"script": "",
+				"parameters": [{"name": "signature", "type": "Signature"}],
+				"deployed": false
+			},
+			"extra": null
+		}
+	],
+	"extra": null
+}`
+
+func TestRead(t *testing.T) {
+	wallet, err := nep6.Read([]byte(testWallet))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(wallet.Accounts))
+	assert.Equal(t, 1, wallet.DefaultIndex())
+
+	_, err = wallet.Decrypt(0, "TestingOneTwoThree")
+
+	assert.Equal(t, nep6.ErrWatchOnly, err)
+
+	_, err = wallet.Decrypt(2, "TestingOneTwoThree")
+
+	assert.Equal(t, nep6.ErrAccountIndex, err)
+
+	key, err := wallet.Decrypt(1, "TestingOneTwoThree")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "cbf4b9f70470856bb4f40f80b87edb90865997ffee6df315ab166d713af433a5", hex.EncodeToString(key.ToBytes()))
+
+	_, err = nep6.Read([]byte(`{"name":"bad","scrypt":{"n":0,"r":8,"p":8}}`))
+
+	assert.Error(t, err)
+}
+
+func TestWrite(t *testing.T) {
+	key, err := keystore.KeyFromWIF("L44B5gGEpqEDRS9vVPz7QT35jcBG2r3CZwSwQ4fCewXAhAhqGVpP")
+
+	assert.NoError(t, err)
+
+	wallet := nep6.New("test")
+
+	// light parameters keep test fast
+	wallet.Scrypt = nep6.Scrypt{N: 1024, R: 8, P: 1}
+
+	account, err := wallet.Add(key, "first", "pass", false)
+
+	assert.NoError(t, err)
+	assert.True(t, account.IsDefault)
+	assert.Equal(t, "21"+hex.EncodeToString(nep6.PublicKey(key))+"ac", account.Contract.Script)
+
+	second, err := keystore.NewKey()
+
+	assert.NoError(t, err)
+
+	_, err = wallet.Add(second, "second", "pass", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, wallet.DefaultIndex())
+
+	data, err := json.Marshal(wallet)
+
+	assert.NoError(t, err)
+
+	wallet, err = nep6.Read(data)
+
+	assert.NoError(t, err)
+
+	decrypted, err := wallet.Decrypt(0, "pass")
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.Address, decrypted.Address)
+
+	decrypted, err = wallet.Decrypt(1, "pass")
+
+	assert.NoError(t, err)
+	assert.Equal(t, second.Address, decrypted.Address)
+}
@@ -0,0 +1,236 @@
+package seedphrase
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/inwecrypto/bip39"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSuggestDistance max edit distance of suggested words
+const MaxSuggestDistance = 2
+
+// Report mnemonic check result
+type Report struct {
+	Valid     bool           `json:"valid"`     // mnemonic is valid
+	WordCount int            `json:"wordCount"` // words count of mnemonic
+	Unknown   []*UnknownWord `json:"unknown"`   // words not in wordlist
+	Repairs   []*Repair      `json:"repairs"`   // single word substitutions pass checksum
+}
+
+// UnknownWord word not in wordlist with close matches
+type UnknownWord struct {
+	Position    int      `json:"position"`
+	Word        string   `json:"word"`
+	Suggestions []string `json:"suggestions"`
+}
+
+// Repair replace word at position makes mnemonic valid
+type Repair struct {
+	Position int    `json:"position"`
+	Word     string `json:"word"`
+	Distance int    `json:"distance"` // edit distance to the replaced word
+}
+
+// Complete get words start with prefix in wordlist order, limit <= 0 means no limit
+func Complete(prefix string, dic *bip39.WordDictionary, limit int) []string {
+	prefix = strings.TrimSpace(norm.NFKD.String(prefix))
+
+	result := make([]string, 0)
+
+	if prefix == "" {
+		return result
+	}
+
+	for _, word := range dic.WordList {
+		if word != "" && strings.HasPrefix(word, prefix) {
+			result = append(result, word)
+
+			if limit > 0 && len(result) == limit {
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// UnknownPositions get positions of words not in wordlist
+func UnknownPositions(mnemonic string, dic *bip39.WordDictionary) []int {
+	positions := make([]int, 0)
+
+	for i, word := range strings.Fields(Normalize(mnemonic)) {
+		if _, ok := dic.ReverseWordMap[word]; !ok {
+			positions = append(positions, i)
+		}
+	}
+
+	return positions
+}
+
+// Suggest get wordlist words within MaxSuggestDistance edits of word, closest first,
+// limit <= 0 means no limit
+func Suggest(word string, dic *bip39.WordDictionary, limit int) []string {
+	word = norm.NFKD.String(word)
+
+	type candidate struct {
+		word     string
+		distance int
+	}
+
+	candidates := make([]candidate, 0)
+
+	for _, w := range dic.WordList {
+		if w == "" {
+			continue
+		}
+
+		if distance := editDistance(word, w); distance <= MaxSuggestDistance {
+			candidates = append(candidates, candidate{w, distance})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	result := make([]string, len(candidates))
+
+	for i, c := range candidates {
+		result[i] = c.word
+	}
+
+	return result
+}
+
+// Repairs get single word substitutions which make mnemonic pass checksum, closest
+// to the replaced word first. If mnemonic has one unknown word only that position is
+// tried, more than one unknown word can not be repaired. limit <= 0 means no limit
+func Repairs(mnemonic string, dic *bip39.WordDictionary, limit int) []*Repair {
+	repairs := make([]*Repair, 0)
+
+	words := strings.Fields(Normalize(mnemonic))
+
+	if len(words)%3 != 0 || len(words) < 12 || len(words) > 24 {
+		return repairs
+	}
+
+	positions := UnknownPositions(mnemonic, dic)
+
+	switch len(positions) {
+	case 0:
+		for i := range words {
+			positions = append(positions, i)
+		}
+	case 1:
+	default:
+		return repairs
+	}
+
+	candidate := make([]string, len(words))
+
+	for _, position := range positions {
+		copy(candidate, words)
+
+		for _, word := range dic.WordList {
+			if word == "" || word == words[position] {
+				continue
+			}
+
+			candidate[position] = word
+
+			if _, err := ToEntropy(strings.Join(candidate, " "), dic); err == nil {
+				repairs = append(repairs, &Repair{
+					Position: position,
+					Word:     word,
+					Distance: editDistance(words[position], word),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(repairs, func(i, j int) bool {
+		return repairs[i].Distance < repairs[j].Distance
+	})
+
+	if limit > 0 && len(repairs) > limit {
+		repairs = repairs[:limit]
+	}
+
+	return repairs
+}
+
+// Check check mnemonic, report unknown words with suggestions and checksum repairs
+func Check(mnemonic string, dic *bip39.WordDictionary, limit int) *Report {
+	words := strings.Fields(Normalize(mnemonic))
+
+	report := &Report{
+		WordCount: len(words),
+		Unknown:   make([]*UnknownWord, 0),
+		Repairs:   make([]*Repair, 0),
+	}
+
+	for _, position := range UnknownPositions(mnemonic, dic) {
+		report.Unknown = append(report.Unknown, &UnknownWord{
+			Position:    position,
+			Word:        words[position],
+			Suggestions: Suggest(words[position], dic, limit),
+		})
+	}
+
+	if _, err := ToEntropy(mnemonic, dic); err == nil {
+		report.Valid = true
+		return report
+	}
+
+	report.Repairs = Repairs(mnemonic, dic, limit)
+
+	return report
+}
+
+// editDistance levenshtein distance of runes
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+
+	if c < a {
+		a = c
+	}
+
+	return a
+}
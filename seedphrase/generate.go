@@ -0,0 +1,102 @@
+package seedphrase
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/inwecrypto/bip39"
+)
+
+// ideographicSpace japanese mnemonic word separator recommended by bip39
+const ideographicSpace = "　"
+
+// NewEntropy create random entropy for mnemonic of words count (12, 15, 18, 21 or 24),
+// non-empty extraEntropy (dice rolls etc.) is mixed with the random bytes by sha256,
+// so it can add but never reduce randomness
+func NewEntropy(words int, extraEntropy []byte) ([]byte, error) {
+	if words%3 != 0 || words < 12 || words > 24 {
+		return nil, fmt.Errorf("invalid mnemonic words count %d", words)
+	}
+
+	entropy, err := bip39.NewEntropy(words / 3 * 32)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(extraEntropy) == 0 {
+		return entropy, nil
+	}
+
+	hasher := sha256.New()
+	hasher.Write(entropy)
+	hasher.Write(extraEntropy)
+
+	mixed := hasher.Sum(nil)[:len(entropy)]
+
+	zeroBytes(entropy)
+
+	return mixed, nil
+}
+
+// FromEntropy encode entropy as mnemonic of lang
+func FromEntropy(entropy []byte, lang string) (string, error) {
+	dic, err := Dict(lang)
+
+	if err != nil {
+		return "", err
+	}
+
+	mnemonic, err := bip39.NewMnemonic(entropy, dic)
+
+	if err != nil {
+		return "", err
+	}
+
+	if lang == "ja_JP" {
+		mnemonic = strings.Replace(mnemonic, " ", ideographicSpace, -1)
+	}
+
+	return mnemonic, nil
+}
+
+// Generate create new random mnemonic of words count and lang
+func Generate(words int, lang string, extraEntropy []byte) (string, error) {
+	entropy, err := NewEntropy(words, extraEntropy)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer zeroBytes(entropy)
+
+	return FromEntropy(entropy, lang)
+}
+
+func zeroBytes(bytes []byte) {
+	for i := range bytes {
+		bytes[i] = 0
+	}
+}
+
+// Translate convert mnemonic to another wordlist language keeping entropy and checksum,
+// empty from means detect language automatically. Note bip39 seed is derived from the
+// words, so the translated mnemonic restores a different wallet
+func Translate(mnemonic string, from string, to string) (string, error) {
+	_, dic, err := ResolveDict(mnemonic, from)
+
+	if err != nil {
+		return "", err
+	}
+
+	entropy, err := ToEntropy(mnemonic, dic)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer zeroBytes(entropy)
+
+	return FromEntropy(entropy, to)
+}
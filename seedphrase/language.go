@@ -0,0 +1,149 @@
+package seedphrase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inwecrypto/bip39"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Languages supported mnemonic languages in detection order, earlier languages
+// win when a mnemonic is valid in more than one list
+var Languages = []string{
+	"en_US", "zh_CN", "zh_TW", "ja_JP", "ko_KR", "es_ES", "fr_FR", "it_IT", "cs_CZ",
+}
+
+func init() {
+	wordlists := map[string]string{
+		"zh_TW": zhTW,
+		"ja_JP": jaJP,
+		"ko_KR": koKR,
+		"es_ES": esES,
+		"fr_FR": frFR,
+		"it_IT": itIT,
+		"cs_CZ": csCZ,
+	}
+
+	// vendored zh_CN list has a stray trailing space after one word and an
+	// empty last entry, register a cleaned copy
+	if dic, ok := bip39.GetDict("zh_CN"); ok {
+		wordlists["zh_CN"] = strings.Join(dic.WordList, "\n")
+	}
+
+	for lang, words := range wordlists {
+		cleaned := strings.Fields(norm.NFKD.String(words))
+
+		bip39.SetDict(lang, strings.Join(cleaned, "\n"), "\n")
+	}
+}
+
+// Dict get word dictionary of language
+func Dict(lang string) (*bip39.WordDictionary, error) {
+	dic, ok := bip39.GetDict(lang)
+
+	if !ok {
+		return nil, fmt.Errorf("unsupported mnemonic language %s", lang)
+	}
+
+	return dic, nil
+}
+
+// DetectLanguage detect mnemonic language by checking which wordlist contains all
+// words, checksum is used to choose between lists sharing the same words
+func DetectLanguage(mnemonic string) (string, error) {
+	words := strings.Fields(Normalize(mnemonic))
+
+	if len(words) == 0 {
+		return "", fmt.Errorf("empty mnemonic")
+	}
+
+	var candidates []string
+
+	for _, lang := range Languages {
+		dic, err := Dict(lang)
+
+		if err != nil {
+			continue
+		}
+
+		if containsAll(dic, words) {
+			candidates = append(candidates, lang)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("unknown mnemonic language")
+	}
+
+	for _, lang := range candidates {
+		dic, _ := Dict(lang)
+
+		if _, err := ToEntropy(mnemonic, dic); err == nil {
+			return lang, nil
+		}
+	}
+
+	return candidates[0], nil
+}
+
+// GuessLanguage get the language whose wordlist contains most words of mnemonic, unlike
+// DetectLanguage mnemonic can contain mistyped words
+func GuessLanguage(mnemonic string) (string, error) {
+	words := strings.Fields(Normalize(mnemonic))
+
+	best, bestCount := "", 0
+
+	for _, lang := range Languages {
+		dic, err := Dict(lang)
+
+		if err != nil {
+			continue
+		}
+
+		count := 0
+
+		for _, word := range words {
+			if _, ok := dic.ReverseWordMap[word]; ok {
+				count++
+			}
+		}
+
+		if count > bestCount {
+			best, bestCount = lang, count
+		}
+	}
+
+	if best == "" {
+		return "", fmt.Errorf("unknown mnemonic language")
+	}
+
+	return best, nil
+}
+
+// ResolveDict get language and word dictionary of lang, or of detected language if lang is empty
+func ResolveDict(mnemonic string, lang string) (string, *bip39.WordDictionary, error) {
+	if lang == "" {
+		detected, err := DetectLanguage(mnemonic)
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		lang = detected
+	}
+
+	dic, err := Dict(lang)
+
+	return lang, dic, err
+}
+
+func containsAll(dic *bip39.WordDictionary, words []string) bool {
+	for _, word := range words {
+		if _, ok := dic.ReverseWordMap[word]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,69 @@
+package seedphrase
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/inwecrypto/bip39"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize clean up mnemonic string as bip39 requires: NFKD normalized and words
+// separated by single space, japanese ideographic spaces are replaced too
+func Normalize(mnemonic string) string {
+	return strings.Join(strings.Fields(norm.NFKD.String(mnemonic)), " ")
+}
+
+// ToEntropy decode mnemonic into entropy bytes and verify the checksum
+func ToEntropy(mnemonic string, dic *bip39.WordDictionary) ([]byte, error) {
+	words := strings.Fields(Normalize(mnemonic))
+
+	if len(words)%3 != 0 || len(words) < 12 || len(words) > 24 {
+		return nil, fmt.Errorf("invalid mnemonic words count %d", len(words))
+	}
+
+	bits := make([]byte, 0, len(words)*11)
+
+	for i, word := range words {
+		index, ok := dic.ReverseWordMap[word]
+
+		if !ok {
+			return nil, fmt.Errorf("invalid mnemonic word %s at position %d", word, i)
+		}
+
+		for j := 10; j >= 0; j-- {
+			bits = append(bits, byte(index>>uint(j))&1)
+		}
+	}
+
+	checksumBits := len(bits) / 33
+	entropyBits := len(bits) - checksumBits
+
+	entropy := make([]byte, entropyBits/8)
+
+	for i := 0; i < entropyBits; i++ {
+		entropy[i/8] |= bits[i] << uint(7-i%8)
+	}
+
+	hash := sha256.Sum256(entropy)
+
+	for i := 0; i < checksumBits; i++ {
+		if bits[entropyBits+i] != (hash[i/8]>>uint(7-i%8))&1 {
+			return nil, fmt.Errorf("invalid mnemonic checksum")
+		}
+	}
+
+	return entropy, nil
+}
+
+// NewSeed verify mnemonic and create bip39 seed with passphrase
+func NewSeed(mnemonic string, passphrase string, dic *bip39.WordDictionary) ([]byte, error) {
+	mnemonic = Normalize(mnemonic)
+
+	if _, err := ToEntropy(mnemonic, dic); err != nil {
+		return nil, err
+	}
+
+	return bip39.NewSeed(mnemonic, norm.NFKD.String(passphrase)), nil
+}
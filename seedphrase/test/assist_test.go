@@ -0,0 +1,97 @@
+package seedphrasetest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/inwecrypto/mobilesdk/seedphrase"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestComplete(t *testing.T) {
+	dic, _ := seedphrase.Dict("en_US")
+
+	assert.Equal(t, []string{"abandon", "ability", "able"}, seedphrase.Complete("ab", dic, 3))
+	assert.Equal(t, []string{"zoo"}, seedphrase.Complete("zoo", dic, 0))
+	assert.Empty(t, seedphrase.Complete("", dic, 0))
+	assert.Empty(t, seedphrase.Complete("xyz", dic, 0))
+
+	dic, _ = seedphrase.Dict("es_ES")
+
+	// composed input matches NFKD wordlist
+	assert.Equal(t, []string{seedphrase.Normalize("ábaco")}, seedphrase.Complete("ábac", dic, 0))
+}
+
+func TestSuggest(t *testing.T) {
+	dic, _ := seedphrase.Dict("en_US")
+
+	suggestions := seedphrase.Suggest("abandn", dic, 3)
+
+	assert.Equal(t, "abandon", suggestions[0])
+
+	assert.Empty(t, seedphrase.Suggest("qqqqqqqq", dic, 0))
+}
+
+func TestCheck(t *testing.T) {
+	dic, _ := seedphrase.Dict("en_US")
+
+	report := seedphrase.Check(testMnemonic, dic, 5)
+
+	assert.True(t, report.Valid)
+	assert.Equal(t, 12, report.WordCount)
+	assert.Empty(t, report.Unknown)
+	assert.Empty(t, report.Repairs)
+
+	// mistyped word
+	report = seedphrase.Check(strings.Replace(testMnemonic, "about", "abuot", 1), dic, 5)
+
+	assert.False(t, report.Valid)
+	assert.Equal(t, 1, len(report.Unknown))
+	assert.Equal(t, 11, report.Unknown[0].Position)
+	assert.Contains(t, report.Unknown[0].Suggestions, "about")
+	assert.NotEmpty(t, report.Repairs)
+
+	for _, repair := range report.Repairs {
+		assert.Equal(t, 11, repair.Position)
+	}
+
+	// wrong but known word, checksum fails
+	broken := strings.Replace(testMnemonic, "about", "above", 1)
+
+	report = seedphrase.Check(broken, dic, 0)
+
+	assert.False(t, report.Valid)
+	assert.Empty(t, report.Unknown)
+
+	found := false
+
+	for _, repair := range report.Repairs {
+		words := strings.Fields(broken)
+		words[repair.Position] = repair.Word
+
+		_, err := seedphrase.ToEntropy(strings.Join(words, " "), dic)
+
+		assert.NoError(t, err)
+
+		if repair.Position == 11 && repair.Word == "about" {
+			found = true
+		}
+	}
+
+	assert.True(t, found)
+
+	// two unknown words can not be repaired
+	report = seedphrase.Check(strings.Replace(testMnemonic, "abandon", "abandn", 2), dic, 0)
+
+	assert.Equal(t, 2, len(report.Unknown))
+	assert.Empty(t, report.Repairs)
+}
+
+func TestGuessLanguage(t *testing.T) {
+	lang, err := seedphrase.GuessLanguage(strings.Replace(testMnemonic, "about", "abuot", 1))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "en_US", lang)
+}
@@ -0,0 +1,162 @@
+package seedphrasetest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/inwecrypto/bip39"
+	"github.com/inwecrypto/mobilesdk/seedphrase"
+	"github.com/stretchr/testify/assert"
+)
+
+// japanese vector from bip39 reference test vectors, words are separated by
+// ideographic space and passphrase needs NFKD normalization
+const (
+	jaMnemonic   = "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら"
+	jaPassphrase = "㍍ガバヴァぱばぐゞちぢ十人十色"
+	jaSeed       = "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55"
+)
+
+func TestJapaneseSeed(t *testing.T) {
+	dic, err := seedphrase.Dict("ja_JP")
+
+	assert.NoError(t, err)
+
+	seed, err := seedphrase.NewSeed(jaMnemonic, jaPassphrase, dic)
+
+	assert.NoError(t, err)
+	assert.Equal(t, jaSeed, hex.EncodeToString(seed))
+}
+
+func TestWordlists(t *testing.T) {
+	for _, lang := range seedphrase.Languages {
+		dic, err := seedphrase.Dict(lang)
+
+		assert.NoError(t, err, lang)
+		assert.Equal(t, 2048, len(dic.WordList), lang)
+		assert.Equal(t, 2048, len(dic.ReverseWordMap), lang)
+	}
+
+	_, err := seedphrase.Dict("xx_XX")
+
+	assert.Error(t, err)
+}
+
+func TestDetectLanguage(t *testing.T) {
+	lang, err := seedphrase.DetectLanguage(jaMnemonic)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ja_JP", lang)
+
+	for _, lang := range seedphrase.Languages {
+		dic, _ := seedphrase.Dict(lang)
+
+		mnemonic, err := bip39.NewMnemonic(bytes.Repeat([]byte{0xa5}, 16), dic)
+
+		assert.NoError(t, err, lang)
+
+		detected, err := seedphrase.DetectLanguage(mnemonic)
+
+		assert.NoError(t, err, lang)
+		assert.Equal(t, lang, detected)
+
+		_, err = seedphrase.ToEntropy(mnemonic, dic)
+
+		assert.NoError(t, err, lang)
+	}
+
+	_, err = seedphrase.DetectLanguage("not a real mnemonic phrase")
+
+	assert.Error(t, err)
+}
+
+func TestGenerate(t *testing.T) {
+	for _, words := range []int{12, 15, 18, 21, 24} {
+		mnemonic, err := seedphrase.Generate(words, "en_US", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, words, len(strings.Fields(mnemonic)))
+
+		dic, _ := seedphrase.Dict("en_US")
+
+		entropy, err := seedphrase.ToEntropy(mnemonic, dic)
+
+		assert.NoError(t, err)
+		assert.Equal(t, words/3*4, len(entropy))
+	}
+
+	for _, words := range []int{0, 11, 13, 27} {
+		_, err := seedphrase.Generate(words, "en_US", nil)
+
+		assert.Error(t, err)
+	}
+
+	mnemonic, err := seedphrase.Generate(12, "ja_JP", []byte("3 6 1 4 2 5 6 6 1 2"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 11, strings.Count(mnemonic, "　"))
+
+	lang, err := seedphrase.DetectLanguage(mnemonic)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ja_JP", lang)
+}
+
+func TestFromEntropy(t *testing.T) {
+	mnemonic, err := seedphrase.FromEntropy(make([]byte, 16), "en_US")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", mnemonic)
+
+	mnemonic, err = seedphrase.FromEntropy(bytes.Repeat([]byte{0xff}, 32), "en_US")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote", mnemonic)
+}
+
+func TestTranslate(t *testing.T) {
+	for _, from := range seedphrase.Languages {
+		mnemonic, err := seedphrase.Generate(24, from, nil)
+
+		assert.NoError(t, err)
+
+		fromDic, _ := seedphrase.Dict(from)
+
+		entropy, err := seedphrase.ToEntropy(mnemonic, fromDic)
+
+		assert.NoError(t, err)
+
+		for _, to := range seedphrase.Languages {
+			translated, err := seedphrase.Translate(mnemonic, from, to)
+
+			assert.NoError(t, err, from+"->"+to)
+
+			toDic, _ := seedphrase.Dict(to)
+
+			translatedEntropy, err := seedphrase.ToEntropy(translated, toDic)
+
+			assert.NoError(t, err, from+"->"+to)
+			assert.Equal(t, entropy, translatedEntropy, from+"->"+to)
+
+			back, err := seedphrase.Translate(translated, to, from)
+
+			assert.NoError(t, err, from+"->"+to)
+			assert.Equal(t, mnemonic, back, from+"->"+to)
+		}
+	}
+
+	translated, err := seedphrase.Translate(testMnemonic, "", "zh_CN")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "的 的 的 的 的 的 的 的 的 的 的 在", translated)
+
+	_, err = seedphrase.Translate(strings.Replace(testMnemonic, "about", "abuot", 1), "en_US", "zh_CN")
+
+	assert.EqualError(t, err, "invalid mnemonic word abuot at position 11")
+
+	_, err = seedphrase.Translate(testMnemonic, "en_US", "xx_XX")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,427 @@
+package siwe
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inwecrypto/mobilesdk/ethtx"
+)
+
+// Errors
+var (
+	ErrMessage        = errors.New("siwe: invalid message format")
+	ErrSigner         = errors.New("siwe: signature is not signed by message address")
+	ErrDomain         = errors.New("siwe: domain mismatch")
+	ErrDomainRequired = errors.New("siwe: expected domain is required")
+	ErrExpired        = errors.New("siwe: message expired")
+	ErrNotYetValid    = errors.New("siwe: message not yet valid")
+	ErrNonce          = errors.New("siwe: nonce mismatch")
+)
+
+// Version only supported message version
+const Version = "1"
+
+const (
+	header        = " wants you to sign in with your Ethereum account:"
+	uriTag        = "URI: "
+	versionTag    = "Version: "
+	chainIDTag    = "Chain ID: "
+	nonceTag      = "Nonce: "
+	issuedAtTag   = "Issued At: "
+	expirationTag = "Expiration Time: "
+	notBeforeTag  = "Not Before: "
+	requestIDTag  = "Request ID: "
+	resourcesTag  = "Resources:"
+	nonceChars    = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// Message eip-4361 sign-in with ethereum message, timestamps are rfc3339 strings
+type Message struct {
+	Scheme         string   `json:"scheme,omitempty"`
+	Domain         string   `json:"domain"`
+	Address        string   `json:"address"`
+	Statement      string   `json:"statement,omitempty"`
+	URI            string   `json:"uri"`
+	Version        string   `json:"version"`
+	ChainID        uint64   `json:"chainId"`
+	Nonce          string   `json:"nonce"`
+	IssuedAt       string   `json:"issuedAt"`
+	ExpirationTime string   `json:"expirationTime,omitempty"`
+	NotBefore      string   `json:"notBefore,omitempty"`
+	RequestID      string   `json:"requestId,omitempty"`
+	Resources      []string `json:"resources,omitempty"`
+}
+
+// GenerateNonce random 16 alphanumeric characters nonce
+func GenerateNonce() (string, error) {
+	max := big.NewInt(int64(len(nonceChars)))
+
+	nonce := make([]byte, 16)
+
+	for i := range nonce {
+		n, err := rand.Int(rand.Reader, max)
+
+		if err != nil {
+			return "", err
+		}
+
+		nonce[i] = nonceChars[n.Int64()]
+	}
+
+	return string(nonce), nil
+}
+
+// Validate check message fields against eip-4361 abnf
+func (msg *Message) Validate() error {
+	if msg.Scheme != "" && !isScheme(msg.Scheme) {
+		return fmt.Errorf("siwe: invalid scheme %s", msg.Scheme)
+	}
+
+	if msg.Domain == "" || strings.ContainsAny(msg.Domain, " \t\r\n/") {
+		return fmt.Errorf("siwe: invalid domain %s", msg.Domain)
+	}
+
+	address, err := ethtx.ParseAddress(msg.Address)
+
+	if err != nil || address == nil || !strings.HasPrefix(msg.Address, "0x") {
+		return fmt.Errorf("siwe: invalid address %s", msg.Address)
+	}
+
+	if ethtx.ChecksumAddress(address[:]) != msg.Address {
+		return fmt.Errorf("siwe: address %s is not eip-55 checksummed", msg.Address)
+	}
+
+	if strings.ContainsAny(msg.Statement, "\r\n") {
+		return fmt.Errorf("siwe: statement must be a single line")
+	}
+
+	if !isURI(msg.URI) {
+		return fmt.Errorf("siwe: invalid uri %s", msg.URI)
+	}
+
+	if msg.Version != Version {
+		return fmt.Errorf("siwe: unsupported version %s", msg.Version)
+	}
+
+	if msg.ChainID == 0 {
+		return fmt.Errorf("siwe: chain id is required")
+	}
+
+	if len(msg.Nonce) < 8 || strings.Trim(msg.Nonce, nonceChars) != "" {
+		return fmt.Errorf("siwe: nonce must be at least 8 alphanumeric characters")
+	}
+
+	if _, err := parseTime(msg.IssuedAt); err != nil {
+		return fmt.Errorf("siwe: invalid issued at %s", msg.IssuedAt)
+	}
+
+	if msg.ExpirationTime != "" {
+		if _, err := parseTime(msg.ExpirationTime); err != nil {
+			return fmt.Errorf("siwe: invalid expiration time %s", msg.ExpirationTime)
+		}
+	}
+
+	if msg.NotBefore != "" {
+		if _, err := parseTime(msg.NotBefore); err != nil {
+			return fmt.Errorf("siwe: invalid not before %s", msg.NotBefore)
+		}
+	}
+
+	if strings.ContainsAny(msg.RequestID, "\r\n") {
+		return fmt.Errorf("siwe: request id must be a single line")
+	}
+
+	for _, resource := range msg.Resources {
+		if !isURI(resource) {
+			return fmt.Errorf("siwe: invalid resource %s", resource)
+		}
+	}
+
+	return nil
+}
+
+// String eip-4361 message text, fields are not validated
+func (msg *Message) String() string {
+	var builder strings.Builder
+
+	if msg.Scheme != "" {
+		builder.WriteString(msg.Scheme + "://")
+	}
+
+	builder.WriteString(msg.Domain + header + "\n")
+	builder.WriteString(msg.Address + "\n\n")
+
+	if msg.Statement != "" {
+		builder.WriteString(msg.Statement + "\n")
+	}
+
+	builder.WriteString("\n")
+	builder.WriteString(uriTag + msg.URI + "\n")
+	builder.WriteString(versionTag + msg.Version + "\n")
+	builder.WriteString(chainIDTag + strconv.FormatUint(msg.ChainID, 10) + "\n")
+	builder.WriteString(nonceTag + msg.Nonce + "\n")
+	builder.WriteString(issuedAtTag + msg.IssuedAt)
+
+	if msg.ExpirationTime != "" {
+		builder.WriteString("\n" + expirationTag + msg.ExpirationTime)
+	}
+
+	if msg.NotBefore != "" {
+		builder.WriteString("\n" + notBeforeTag + msg.NotBefore)
+	}
+
+	if msg.RequestID != "" {
+		builder.WriteString("\n" + requestIDTag + msg.RequestID)
+	}
+
+	if len(msg.Resources) > 0 {
+		builder.WriteString("\n" + resourcesTag)
+
+		for _, resource := range msg.Resources {
+			builder.WriteString("\n- " + resource)
+		}
+	}
+
+	return builder.String()
+}
+
+// Parse strictly parse eip-4361 message text, the parsed message is validated
+// and formats back to exactly the same text
+func Parse(text string) (*Message, error) {
+	lines := strings.Split(text, "\n")
+
+	reader := &lineReader{lines: lines}
+
+	msg := &Message{}
+
+	line, ok := reader.next()
+
+	if !ok || !strings.HasSuffix(line, header) {
+		return nil, ErrMessage
+	}
+
+	msg.Domain = strings.TrimSuffix(line, header)
+
+	if i := strings.Index(msg.Domain, "://"); i >= 0 {
+		msg.Scheme, msg.Domain = msg.Domain[:i], msg.Domain[i+3:]
+	}
+
+	if msg.Address, ok = reader.next(); !ok {
+		return nil, ErrMessage
+	}
+
+	if line, ok = reader.next(); !ok || line != "" {
+		return nil, ErrMessage
+	}
+
+	if line, ok = reader.next(); !ok {
+		return nil, ErrMessage
+	}
+
+	if line != "" {
+		msg.Statement = line
+
+		if line, ok = reader.next(); !ok || line != "" {
+			return nil, ErrMessage
+		}
+	}
+
+	var err error
+
+	if msg.URI, err = reader.tag(uriTag); err != nil {
+		return nil, err
+	}
+
+	if msg.Version, err = reader.tag(versionTag); err != nil {
+		return nil, err
+	}
+
+	chainID, err := reader.tag(chainIDTag)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.ChainID, err = strconv.ParseUint(chainID, 10, 64); err != nil || strconv.FormatUint(msg.ChainID, 10) != chainID {
+		return nil, fmt.Errorf("siwe: invalid chain id %s", chainID)
+	}
+
+	if msg.Nonce, err = reader.tag(nonceTag); err != nil {
+		return nil, err
+	}
+
+	if msg.IssuedAt, err = reader.tag(issuedAtTag); err != nil {
+		return nil, err
+	}
+
+	msg.ExpirationTime = reader.optionalTag(expirationTag)
+	msg.NotBefore = reader.optionalTag(notBeforeTag)
+	msg.RequestID = reader.optionalTag(requestIDTag)
+
+	if line, ok = reader.next(); ok {
+		if line != resourcesTag {
+			return nil, ErrMessage
+		}
+
+		for {
+			if line, ok = reader.next(); !ok {
+				break
+			}
+
+			if !strings.HasPrefix(line, "- ") {
+				return nil, ErrMessage
+			}
+
+			msg.Resources = append(msg.Resources, strings.TrimPrefix(line, "- "))
+		}
+
+		if len(msg.Resources) == 0 {
+			return nil, ErrMessage
+		}
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// Hash eip-191 personal message hash of message text
+func (msg *Message) Hash() []byte {
+	return ethtx.PersonalMessageHash([]byte(msg.String()))
+}
+
+// Verify check message fields, signature recovered signer, domain binding and
+// time window at now. domain is the expected origin and is required, empty nonce
+// skips the nonce check
+func (msg *Message) Verify(signature []byte, domain, nonce string, now time.Time) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	if domain == "" {
+		return ErrDomainRequired
+	}
+
+	if domain != msg.Domain {
+		return ErrDomain
+	}
+
+	if nonce != "" && nonce != msg.Nonce {
+		return ErrNonce
+	}
+
+	if msg.ExpirationTime != "" {
+		expirationTime, _ := parseTime(msg.ExpirationTime)
+
+		if !now.Before(expirationTime) {
+			return ErrExpired
+		}
+	}
+
+	if msg.NotBefore != "" {
+		notBefore, _ := parseTime(msg.NotBefore)
+
+		if now.Before(notBefore) {
+			return ErrNotYetValid
+		}
+	}
+
+	signer, err := ethtx.RecoverSigner(msg.Hash(), signature)
+
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(signer, msg.Address) {
+		return ErrSigner
+	}
+
+	return nil
+}
+
+// VerifyHex same as Verify with 0x prefixed hex signature
+func (msg *Message) VerifyHex(signature string, domain, nonce string, now time.Time) error {
+	data, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+
+	if err != nil {
+		return err
+	}
+
+	return msg.Verify(data, domain, nonce, now)
+}
+
+type lineReader struct {
+	lines []string
+	index int
+}
+
+func (reader *lineReader) next() (string, bool) {
+	if reader.index >= len(reader.lines) {
+		return "", false
+	}
+
+	line := reader.lines[reader.index]
+
+	reader.index++
+
+	return line, true
+}
+
+func (reader *lineReader) tag(tag string) (string, error) {
+	line, ok := reader.next()
+
+	if !ok || !strings.HasPrefix(line, tag) {
+		return "", fmt.Errorf("siwe: expect %s", strings.TrimSpace(tag))
+	}
+
+	return strings.TrimPrefix(line, tag), nil
+}
+
+func (reader *lineReader) optionalTag(tag string) string {
+	if reader.index < len(reader.lines) && strings.HasPrefix(reader.lines[reader.index], tag) {
+		reader.index++
+
+		return strings.TrimPrefix(reader.lines[reader.index-1], tag)
+	}
+
+	return ""
+}
+
+func parseTime(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+func isScheme(scheme string) bool {
+	for i, c := range scheme {
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' {
+			continue
+		}
+
+		if i > 0 && (c >= '0' && c <= '9' || c == '+' || c == '-' || c == '.') {
+			continue
+		}
+
+		return false
+	}
+
+	return scheme != ""
+}
+
+func isURI(value string) bool {
+	if strings.ContainsAny(value, " \t\r\n") {
+		return false
+	}
+
+	uri, err := url.Parse(value)
+
+	return err == nil && isScheme(uri.Scheme)
+}
@@ -0,0 +1,150 @@
+package siwetest
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inwecrypto/ethgo/keystore"
+	"github.com/inwecrypto/mobilesdk/ethtx"
+	"github.com/inwecrypto/mobilesdk/siwe"
+	"github.com/stretchr/testify/assert"
+)
+
+const messageText = `service.org wants you to sign in with your Ethereum account:
+0x2c7536E3605D9C16a7a3D7b1898e529396a65c23
+
+I accept the ServiceOrg Terms of Service: https://service.org/tos
+
+URI: https://service.org/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Expiration Time: 2021-10-01T16:25:24Z
+Not Before: 2021-09-30T16:00:00Z
+Request ID: some-request
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func newMessage() *siwe.Message {
+	return &siwe.Message{
+		Domain:         "service.org",
+		Address:        "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23",
+		Statement:      "I accept the ServiceOrg Terms of Service: https://service.org/tos",
+		URI:            "https://service.org/login",
+		Version:        "1",
+		ChainID:        1,
+		Nonce:          "32891756",
+		IssuedAt:       "2021-09-30T16:25:24Z",
+		ExpirationTime: "2021-10-01T16:25:24Z",
+		NotBefore:      "2021-09-30T16:00:00Z",
+		RequestID:      "some-request",
+		Resources: []string{
+			"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/",
+			"https://example.com/my-web2-claim.json",
+		},
+	}
+}
+
+func TestMessage(t *testing.T) {
+	msg := newMessage()
+
+	assert.NoError(t, msg.Validate())
+	assert.Equal(t, messageText, msg.String())
+
+	parsed, err := siwe.Parse(messageText)
+
+	assert.NoError(t, err)
+	assert.Equal(t, msg, parsed)
+
+	minimal := &siwe.Message{
+		Domain:   "localhost:4361",
+		Address:  msg.Address,
+		URI:      "http://localhost:4361",
+		Version:  "1",
+		ChainID:  5,
+		Nonce:    "abcdEFGH1234",
+		IssuedAt: "2021-09-30T16:25:24.000Z",
+	}
+
+	text := minimal.String()
+
+	assert.Equal(t, "localhost:4361 wants you to sign in with your Ethereum account:\n"+msg.Address+"\n\n\nURI: http://localhost:4361\nVersion: 1\nChain ID: 5\nNonce: abcdEFGH1234\nIssued At: 2021-09-30T16:25:24.000Z", text)
+
+	parsed, err = siwe.Parse(text)
+
+	assert.NoError(t, err)
+	assert.Equal(t, minimal, parsed)
+
+	minimal.Scheme = "https"
+
+	parsed, err = siwe.Parse(minimal.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, minimal, parsed)
+
+	nonce, err := siwe.GenerateNonce()
+
+	assert.NoError(t, err)
+	assert.Len(t, nonce, 16)
+}
+
+func TestParseInvalid(t *testing.T) {
+	invalid := []string{
+		"",
+		messageText + "\n",
+		strings.Replace(messageText, "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23", 1),
+		strings.Replace(messageText, "Version: 1", "Version: 2", 1),
+		strings.Replace(messageText, "Chain ID: 1", "Chain ID: 01", 1),
+		strings.Replace(messageText, "Nonce: 32891756", "Nonce: 1234", 1),
+		strings.Replace(messageText, "Nonce: 32891756", "Nonce: 1234-5678", 1),
+		strings.Replace(messageText, "Issued At: 2021-09-30T16:25:24Z", "Issued At: 2021-09-30", 1),
+		strings.Replace(messageText, "URI: https://service.org/login", "URI: service.org login", 1),
+		strings.Replace(messageText, "\nVersion: 1", "", 1),
+		strings.Replace(messageText, "Resources:\n", "Resources:\nfoo\n", 1),
+		strings.Replace(messageText, "Terms of Service: https://service.org/tos\n", "Terms of Service: https://service.org/tos", 1),
+		strings.Replace(messageText, "Not Before: 2021-09-30T16:00:00Z\nRequest ID: some-request", "Request ID: some-request\nNot Before: 2021-09-30T16:00:00Z", 1),
+	}
+
+	for _, text := range invalid {
+		_, err := siwe.Parse(text)
+
+		assert.Error(t, err, text)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	privateKey, _ := hex.DecodeString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+
+	key, err := keystore.KeyFromPrivateKey(privateKey)
+
+	assert.NoError(t, err)
+
+	msg := newMessage()
+
+	signature, err := ethtx.SignHash(ethtx.PersonalMessageHash([]byte(messageText)), key.PrivateKey)
+
+	assert.NoError(t, err)
+
+	now, _ := time.Parse(time.RFC3339, "2021-09-30T17:00:00Z")
+
+	assert.NoError(t, msg.Verify(signature, "service.org", "32891756", now))
+	assert.Equal(t, siwe.ErrDomainRequired, msg.Verify(signature, "", "", now))
+	assert.NoError(t, msg.VerifyHex("0x"+hex.EncodeToString(signature), "service.org", "", now))
+
+	assert.Equal(t, siwe.ErrDomain, msg.Verify(signature, "evil.org", "", now))
+	assert.Equal(t, siwe.ErrNonce, msg.Verify(signature, "service.org", "12345678", now))
+	assert.Equal(t, siwe.ErrExpired, msg.Verify(signature, "service.org", "", now.Add(24*time.Hour)))
+	assert.Equal(t, siwe.ErrNotYetValid, msg.Verify(signature, "service.org", "", now.Add(-2*time.Hour)))
+
+	msg.ChainID = 5
+
+	assert.Equal(t, siwe.ErrSigner, msg.Verify(signature, "service.org", "", now))
+
+	msg.ChainID = 1
+
+	assert.Equal(t, ethtx.ErrSignatureLength, msg.Verify(signature[:64], "service.org", "", now))
+}
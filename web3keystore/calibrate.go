@@ -0,0 +1,108 @@
+package web3keystore
+
+import (
+	"time"
+)
+
+// calibration bounds, scrypt memory usage is 128 * N * r bytes, so N is
+// capped at the standard keystore value to stay usable on mobile devices
+const (
+	minScryptN    = 1 << 14
+	maxScryptN    = 1 << 18
+	calibrateN    = 1 << 12
+	minPBKDF2C    = 10000
+	maxPBKDF2C    = 10000000
+	calibrateC    = 10000
+	pbkdf2CRound  = 1000
+	calibrateRuns = 3
+)
+
+// Calibrate pick the strongest kdf parameters that still derive key within target
+// milliseconds on current device, result is never weaker than the lower bound
+// of each kdf
+func Calibrate(kdf string, targetMillis int) (*Options, error) {
+	if targetMillis <= 0 {
+		return nil, ErrOptions
+	}
+
+	target := time.Duration(targetMillis) * time.Millisecond
+
+	switch kdf {
+	case KDFScrypt:
+		probe := &Options{KDF: KDFScrypt, N: calibrateN, R: 8, P: 1}
+
+		elapsed, err := measure(probe)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// scrypt cost grows linearly with N
+		n := minScryptN
+
+		for n < maxScryptN && elapsed*time.Duration(n*2/calibrateN) <= target {
+			n *= 2
+		}
+
+		probe.N = n
+
+		return probe, nil
+	case KDFPBKDF2:
+		probe := PBKDF2Options(calibrateC)
+
+		elapsed, err := measure(probe)
+
+		if err != nil {
+			return nil, err
+		}
+
+		c := minPBKDF2C
+
+		if elapsed > 0 {
+			c = int(int64(target) * calibrateC / int64(elapsed))
+		}
+
+		c = c / pbkdf2CRound * pbkdf2CRound
+
+		if c < minPBKDF2C {
+			c = minPBKDF2C
+		}
+
+		if c > maxPBKDF2C {
+			c = maxPBKDF2C
+		}
+
+		probe.C = c
+
+		return probe, nil
+	}
+
+	return nil, ErrKDF
+}
+
+// measure get fastest key derivation time of options
+func measure(options *Options) (time.Duration, error) {
+	salt, err := randomBytes(32)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var fastest time.Duration
+
+	for i := 0; i < calibrateRuns; i++ {
+		start := time.Now()
+
+		if _, _, err := deriveKey([]byte("calibrate"), salt, options); err != nil {
+			return 0, err
+		}
+
+		elapsed := time.Since(start)
+
+		if i == 0 || elapsed < fastest {
+			fastest = elapsed
+		}
+	}
+
+	return fastest, nil
+}
@@ -0,0 +1,286 @@
+package web3keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/inwecrypto/keystore"
+	"github.com/inwecrypto/sha3"
+	"github.com/pborman/uuid"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Supported kdf names
+const (
+	KDFScrypt = "scrypt"
+	KDFPBKDF2 = "pbkdf2"
+)
+
+const dkLen = 32
+
+// Errors
+var (
+	ErrKDF     = errors.New("web3keystore: unsupported kdf")
+	ErrOptions = errors.New("web3keystore: invalid kdf parameters")
+	ErrDecrypt = errors.New("web3keystore: could not decrypt, wrong password or corrupted data")
+	ErrKey     = errors.New("web3keystore: private key longer than 32 bytes")
+)
+
+// Options keystore kdf options, N/R/P are used by scrypt and C by pbkdf2
+type Options struct {
+	KDF string
+	N   int
+	R   int
+	P   int
+	C   int
+}
+
+// LightOptions light scrypt options, same as keystore.WriteLightScryptKeyStore
+func LightOptions() *Options {
+	return &Options{KDF: KDFScrypt, N: 1 << 12, R: 8, P: 6}
+}
+
+// StandardOptions standard scrypt options, same as keystore.WriteScryptKeyStore
+func StandardOptions() *Options {
+	return &Options{KDF: KDFScrypt, N: 1 << 18, R: 8, P: 1}
+}
+
+// PBKDF2Options pbkdf2-hmac-sha256 options with iteration count c
+func PBKDF2Options(c int) *Options {
+	return &Options{KDF: KDFPBKDF2, C: c}
+}
+
+// Validate check kdf parameters
+func (options *Options) Validate() error {
+	switch options.KDF {
+	case KDFScrypt:
+		if options.N <= 1 || options.N&(options.N-1) != 0 || options.R <= 0 || options.P <= 0 {
+			return ErrOptions
+		}
+	case KDFPBKDF2:
+		if options.C <= 0 {
+			return ErrOptions
+		}
+	default:
+		return ErrKDF
+	}
+
+	return nil
+}
+
+type keyJSON struct {
+	Address string     `json:"address"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams cipherParamsJSON       `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// Encrypt write key as web3 v3 keystore with kdf options, nil options means LightOptions.
+// Use it instead of keystore.Web3KeyStore.Write, whose ScryptN/ScryptP attrs are
+// ignored by the vendored revision
+func Encrypt(key *keystore.Key, password string, options *Options) ([]byte, error) {
+	if options == nil {
+		options = LightOptions()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(key.PrivateKey) > 32 {
+		return nil, ErrKey
+	}
+
+	keyBytes := make([]byte, 32)
+
+	copy(keyBytes[32-len(key.PrivateKey):], key.PrivateKey)
+
+	defer zeroBytes(keyBytes)
+
+	crypto, err := encryptData(keyBytes, password, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&keyJSON{
+		Address: key.Address,
+		Crypto:  *crypto,
+		ID:      uuid.UUID(key.ID).String(),
+		Version: 3,
+	})
+}
+
+// encryptData encrypt data with aes-128-ctr by key derived from password
+func encryptData(data []byte, password string, options *Options) (*cryptoJSON, error) {
+	salt, err := randomBytes(32)
+
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, kdfParams, err := deriveKey([]byte(password), salt, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer zeroBytes(derivedKey)
+
+	iv, err := randomBytes(aes.BlockSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText := make([]byte, len(data))
+
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, data)
+
+	return &cryptoJSON{
+		Cipher:     "aes-128-ctr",
+		CipherText: hex.EncodeToString(cipherText),
+		CipherParams: cipherParamsJSON{
+			IV: hex.EncodeToString(iv),
+		},
+		KDF:       options.KDF,
+		KDFParams: kdfParams,
+		MAC:       hex.EncodeToString(mac(derivedKey, cipherText)),
+	}, nil
+}
+
+// decryptData decrypt data encrypted by encryptData, mac is checked before decryption
+func decryptData(crypto *cryptoJSON, password string) ([]byte, error) {
+	if crypto.Cipher != "aes-128-ctr" {
+		return nil, ErrDecrypt
+	}
+
+	options, err := cryptoOptions(crypto)
+
+	if err != nil {
+		return nil, err
+	}
+
+	salt, _ := crypto.KDFParams["salt"].(string)
+
+	saltBytes, err := hex.DecodeString(salt)
+
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	cipherText, err := hex.DecodeString(crypto.CipherText)
+
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	iv, err := hex.DecodeString(crypto.CipherParams.IV)
+
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, ErrDecrypt
+	}
+
+	derivedKey, _, err := deriveKey([]byte(password), saltBytes, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer zeroBytes(derivedKey)
+
+	if hex.EncodeToString(mac(derivedKey, cipherText)) != strings.ToLower(crypto.MAC) {
+		return nil, ErrDecrypt
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, len(cipherText))
+
+	cipher.NewCTR(block, iv).XORKeyStream(data, cipherText)
+
+	return data, nil
+}
+
+func mac(derivedKey, cipherText []byte) []byte {
+	hasher := sha3.NewKeccak256()
+
+	hasher.Write(derivedKey[16:32])
+	hasher.Write(cipherText)
+
+	return hasher.Sum(nil)
+}
+
+func deriveKey(password, salt []byte, options *Options) ([]byte, map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"dklen": dkLen,
+		"salt":  hex.EncodeToString(salt),
+	}
+
+	switch options.KDF {
+	case KDFScrypt:
+		derivedKey, err := scrypt.Key(password, salt, options.N, options.R, options.P, dkLen)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		params["n"] = options.N
+		params["r"] = options.R
+		params["p"] = options.P
+
+		return derivedKey, params, nil
+	case KDFPBKDF2:
+		params["c"] = options.C
+		params["prf"] = "hmac-sha256"
+
+		return pbkdf2.Key(password, salt, options.C, dkLen, sha256.New), params, nil
+	}
+
+	return nil, nil, ErrKDF
+}
+
+func randomBytes(n int) ([]byte, error) {
+	buff := make([]byte, n)
+
+	_, err := io.ReadFull(rand.Reader, buff)
+
+	return buff, err
+}
+
+func zeroBytes(bytes []byte) {
+	for i := range bytes {
+		bytes[i] = 0
+	}
+}
@@ -0,0 +1,43 @@
+package web3keystore
+
+import (
+	"encoding/json"
+)
+
+const metaField = "meta"
+
+// Meta wallet metadata stored along with web3 keystore json,
+// other keystore readers ignore this field
+type Meta struct {
+	Passphrase bool `json:"passphrase"` // wallet seed is protected by bip39 passphrase
+}
+
+// WriteMeta set metadata of keystore json
+func WriteMeta(data []byte, meta *Meta) ([]byte, error) {
+	kv := make(map[string]interface{})
+
+	if err := json.Unmarshal(data, &kv); err != nil {
+		return nil, err
+	}
+
+	kv[metaField] = meta
+
+	return json.Marshal(kv)
+}
+
+// ReadMeta get metadata of keystore json, return empty metadata if keystore has none
+func ReadMeta(data []byte) (*Meta, error) {
+	var ks struct {
+		Meta *Meta `json:"meta"`
+	}
+
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, err
+	}
+
+	if ks.Meta == nil {
+		return &Meta{}, nil
+	}
+
+	return ks.Meta, nil
+}
@@ -0,0 +1,103 @@
+package web3keystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/inwecrypto/keystore"
+)
+
+// ErrVerify re-encrypted keystore does not decrypt back to the original key
+var ErrVerify = errors.New("web3keystore: re-encrypted keystore verification failed")
+
+// ChangePassword decrypt keystore with old password and re-encrypt it with new
+// password, fresh salt and iv. Key id, address and metadata are kept, nil options
+// keeps the kdf parameters of the original keystore.
+func ChangePassword(data []byte, oldPassword, newPassword string, options *Options) ([]byte, error) {
+	if options == nil {
+		var err error
+
+		if options, err = ReadOptions(data); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := keystore.Decrypt(data, oldPassword)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer zeroBytes(key.PrivateKey)
+
+	encrypted, err := Encrypt(key, newPassword, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	verified, err := keystore.Decrypt(encrypted, newPassword)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer zeroBytes(verified.PrivateKey)
+
+	if !bytes.Equal(verified.PrivateKey, key.PrivateKey) ||
+		!bytes.Equal(verified.ID, key.ID) ||
+		verified.Address != key.Address {
+		return nil, ErrVerify
+	}
+
+	meta, err := ReadMeta(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if *meta == (Meta{}) {
+		return encrypted, nil
+	}
+
+	return WriteMeta(encrypted, meta)
+}
+
+// ReadOptions get kdf options of keystore
+func ReadOptions(data []byte) (*Options, error) {
+	var ks keyJSON
+
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, err
+	}
+
+	return cryptoOptions(&ks.Crypto)
+}
+
+// cryptoOptions get kdf options of keystore crypto section
+func cryptoOptions(crypto *cryptoJSON) (*Options, error) {
+	params := crypto.KDFParams
+
+	options := &Options{
+		KDF: crypto.KDF,
+		N:   intParam(params, "n"),
+		R:   intParam(params, "r"),
+		P:   intParam(params, "p"),
+		C:   intParam(params, "c"),
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+func intParam(params map[string]interface{}, name string) int {
+	if value, ok := params[name].(float64); ok {
+		return int(value)
+	}
+
+	return 0
+}
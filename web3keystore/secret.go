@@ -0,0 +1,56 @@
+package web3keystore
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pborman/uuid"
+)
+
+type secretJSON struct {
+	Type    string     `json:"type"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+// EncryptSecret write arbitrary secret such as wallet mnemonic as keystore style json
+// with the same cipher and kdf as key keystore, kind tags the secret content and
+// nil options means LightOptions
+func EncryptSecret(secret []byte, kind string, password string, options *Options) ([]byte, error) {
+	if options == nil {
+		options = LightOptions()
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	crypto, err := encryptData(secret, password, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&secretJSON{
+		Type:    kind,
+		Crypto:  *crypto,
+		ID:      uuid.NewRandom().String(),
+		Version: 3,
+	})
+}
+
+// DecryptSecret read secret written by EncryptSecret, kind must match
+func DecryptSecret(data []byte, kind string, password string) ([]byte, error) {
+	var secret secretJSON
+
+	if err := json.Unmarshal(data, &secret); err != nil {
+		return nil, err
+	}
+
+	if secret.Type != kind {
+		return nil, fmt.Errorf("web3keystore: expect %s keystore, got %s", kind, secret.Type)
+	}
+
+	return decryptData(&secret.Crypto, password)
+}
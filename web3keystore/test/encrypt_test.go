@@ -0,0 +1,157 @@
+package web3keystoretest
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/inwecrypto/keystore"
+	"github.com/inwecrypto/mobilesdk/web3keystore"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func testKey() *keystore.Key {
+	return &keystore.Key{
+		ID:         uuid.NewRandom(),
+		Address:    "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		PrivateKey: bytes.Repeat([]byte{0x46}, 32),
+	}
+}
+
+func kdfOf(t *testing.T, data []byte) (string, map[string]interface{}) {
+	var ks struct {
+		Crypto struct {
+			KDF       string                 `json:"kdf"`
+			KDFParams map[string]interface{} `json:"kdfparams"`
+		} `json:"crypto"`
+	}
+
+	assert.NoError(t, json.Unmarshal(data, &ks))
+
+	return ks.Crypto.KDF, ks.Crypto.KDFParams
+}
+
+func TestEncryptScrypt(t *testing.T) {
+	key := testKey()
+
+	data, err := web3keystore.Encrypt(key, "password", &web3keystore.Options{KDF: web3keystore.KDFScrypt, N: 1 << 10, R: 8, P: 2})
+
+	assert.NoError(t, err)
+
+	kdf, params := kdfOf(t, data)
+
+	assert.Equal(t, "scrypt", kdf)
+	assert.Equal(t, float64(1<<10), params["n"])
+	assert.Equal(t, float64(2), params["p"])
+
+	decrypted, err := keystore.Decrypt(data, "password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.PrivateKey, decrypted.PrivateKey)
+	assert.Equal(t, key.Address, decrypted.Address)
+	assert.Equal(t, []byte(key.ID), decrypted.ID)
+
+	_, err = keystore.Decrypt(data, "wrong")
+
+	assert.Error(t, err)
+}
+
+func TestEncryptPBKDF2(t *testing.T) {
+	key := testKey()
+
+	data, err := web3keystore.Encrypt(key, "password", web3keystore.PBKDF2Options(1000))
+
+	assert.NoError(t, err)
+
+	kdf, params := kdfOf(t, data)
+
+	assert.Equal(t, "pbkdf2", kdf)
+	assert.Equal(t, float64(1000), params["c"])
+	assert.Equal(t, "hmac-sha256", params["prf"])
+
+	decrypted, err := keystore.Decrypt(data, "password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.PrivateKey, decrypted.PrivateKey)
+}
+
+func TestEncryptInvalidOptions(t *testing.T) {
+	key := testKey()
+
+	_, err := web3keystore.Encrypt(key, "password", &web3keystore.Options{KDF: web3keystore.KDFScrypt, N: 1000, R: 8, P: 1})
+
+	assert.Equal(t, web3keystore.ErrOptions, err)
+
+	_, err = web3keystore.Encrypt(key, "password", web3keystore.PBKDF2Options(0))
+
+	assert.Equal(t, web3keystore.ErrOptions, err)
+
+	_, err = web3keystore.Encrypt(key, "password", &web3keystore.Options{KDF: "argon2"})
+
+	assert.Equal(t, web3keystore.ErrKDF, err)
+
+	key.PrivateKey = make([]byte, 33)
+
+	_, err = web3keystore.Encrypt(key, "password", nil)
+
+	assert.Equal(t, web3keystore.ErrKey, err)
+}
+
+func TestCalibrate(t *testing.T) {
+	options, err := web3keystore.Calibrate(web3keystore.KDFScrypt, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1<<14, options.N)
+	assert.NoError(t, options.Validate())
+
+	options, err = web3keystore.Calibrate(web3keystore.KDFPBKDF2, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10000, options.C)
+
+	options, err = web3keystore.Calibrate(web3keystore.KDFPBKDF2, 200)
+
+	assert.NoError(t, err)
+	assert.True(t, options.C >= 10000)
+	assert.Equal(t, 0, options.C%1000)
+
+	_, err = web3keystore.Calibrate("argon2", 100)
+
+	assert.Equal(t, web3keystore.ErrKDF, err)
+}
+
+func TestEncryptSecret(t *testing.T) {
+	secret := []byte("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+
+	data, err := web3keystore.EncryptSecret(secret, "hdwallet", "password", nil)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "abandon")
+
+	kdf, _ := kdfOf(t, data)
+
+	assert.Equal(t, web3keystore.KDFScrypt, kdf)
+
+	decrypted, err := web3keystore.DecryptSecret(data, "hdwallet", "password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, secret, decrypted)
+
+	_, err = web3keystore.DecryptSecret(data, "hdwallet", "wrong")
+
+	assert.Equal(t, web3keystore.ErrDecrypt, err)
+
+	_, err = web3keystore.DecryptSecret(data, "other", "password")
+
+	assert.Error(t, err)
+
+	data, err = web3keystore.EncryptSecret(secret, "hdwallet", "password", web3keystore.PBKDF2Options(1000))
+
+	assert.NoError(t, err)
+
+	decrypted, err = web3keystore.DecryptSecret(data, "hdwallet", "password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, secret, decrypted)
+}
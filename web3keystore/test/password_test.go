@@ -0,0 +1,83 @@
+package web3keystoretest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/inwecrypto/keystore"
+	"github.com/inwecrypto/mobilesdk/web3keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+func cryptoOf(t *testing.T, data []byte) (string, string) {
+	var ks struct {
+		Crypto struct {
+			CipherParams struct {
+				IV string `json:"iv"`
+			} `json:"cipherparams"`
+			KDFParams struct {
+				Salt string `json:"salt"`
+			} `json:"kdfparams"`
+		} `json:"crypto"`
+	}
+
+	assert.NoError(t, json.Unmarshal(data, &ks))
+
+	return ks.Crypto.KDFParams.Salt, ks.Crypto.CipherParams.IV
+}
+
+func TestChangePassword(t *testing.T) {
+	key := testKey()
+
+	data, err := web3keystore.Encrypt(key, "old", &web3keystore.Options{KDF: web3keystore.KDFScrypt, N: 1 << 10, R: 8, P: 1})
+
+	assert.NoError(t, err)
+
+	data, err = web3keystore.WriteMeta(data, &web3keystore.Meta{Passphrase: true})
+
+	assert.NoError(t, err)
+
+	changed, err := web3keystore.ChangePassword(data, "old", "new", nil)
+
+	assert.NoError(t, err)
+
+	oldSalt, oldIV := cryptoOf(t, data)
+	newSalt, newIV := cryptoOf(t, changed)
+
+	assert.NotEqual(t, oldSalt, newSalt)
+	assert.NotEqual(t, oldIV, newIV)
+
+	options, err := web3keystore.ReadOptions(changed)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1<<10, options.N)
+
+	meta, err := web3keystore.ReadMeta(changed)
+
+	assert.NoError(t, err)
+	assert.True(t, meta.Passphrase)
+
+	_, err = keystore.Decrypt(changed, "old")
+
+	assert.Error(t, err)
+
+	decrypted, err := keystore.Decrypt(changed, "new")
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.PrivateKey, decrypted.PrivateKey)
+	assert.Equal(t, key.Address, decrypted.Address)
+	assert.Equal(t, []byte(key.ID), decrypted.ID)
+
+	_, err = web3keystore.ChangePassword(data, "wrong", "new", nil)
+
+	assert.Error(t, err)
+
+	changed, err = web3keystore.ChangePassword(data, "old", "new", web3keystore.PBKDF2Options(1000))
+
+	assert.NoError(t, err)
+
+	options, err = web3keystore.ReadOptions(changed)
+
+	assert.NoError(t, err)
+	assert.Equal(t, web3keystore.KDFPBKDF2, options.KDF)
+}